@@ -25,18 +25,24 @@ import (
 	httpmiddlewarestd "github.com/slok/go-http-metrics/middleware/std"
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	"github.com/weaveworks/weave-gitops/core/fluxmetrics"
 	"github.com/weaveworks/weave-gitops/core/logger"
 	"github.com/weaveworks/weave-gitops/core/nsaccess"
 	core "github.com/weaveworks/weave-gitops/core/server"
+	"github.com/weaveworks/weave-gitops/core/tracing"
 	"github.com/weaveworks/weave-gitops/pkg/featureflags"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/server"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
 	"github.com/weaveworks/weave-gitops/pkg/telemetry"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/config"
@@ -46,6 +52,13 @@ import (
 const (
 	// Allowed login requests per second
 	loginRequestRateLimit = 20
+
+	sessionStoreCookie = "cookie"
+	sessionStoreMemory = "memory"
+	sessionStoreSecret = "secret"
+
+	// How often expired sessions are removed from the session store
+	sessionPruneInterval = 10 * time.Minute
 )
 
 // Options contains all the options for the gitops-server command.
@@ -68,6 +81,10 @@ type Options struct {
 	// OIDC
 	OIDC       auth.OIDCConfig
 	OIDCSecret string
+	// Sessions
+	SessionStore string
+	SigningKeys  auth.SigningKeyConfig
+	LoginLockout auth.LoginLockout
 	// Dev mode
 	DevMode bool
 	// Metrics
@@ -75,6 +92,16 @@ type Options struct {
 	MetricsAddress string
 
 	UseK8sCachedClients bool
+	// Namespace access
+	NamespaceAccessRulesConfigMap string
+	// Multi-cluster
+	KubeconfigSecretsSelector string
+	EnableCAPIClusters        bool
+	// Audit
+	AuditLogFile          string
+	AuditKubernetesEvents bool
+	// Tracing
+	Tracing tracing.Options
 }
 
 var options Options
@@ -99,6 +126,9 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&options.Port, "port", server.DefaultPort, "UI port")
 	cmd.Flags().StringSliceVar(&options.AuthMethods, "auth-methods", auth.DefaultAuthMethodStrings(), fmt.Sprintf("Which auth methods to use, valid values are %s", strings.Join(auth.DefaultAuthMethodStrings(), ",")))
 	cmd.Flags().BoolVar(&options.UseK8sCachedClients, "use-k8s-cached-clients", false, "Enables the use of cached clients")
+	cmd.Flags().StringVar(&options.KubeconfigSecretsSelector, "kubeconfig-secrets-selector", "", "Label selector for secrets in the server namespace holding kubeconfigs of leaf clusters to add to the dashboard, e.g. weave.works/cluster=true. Leaf clusters are disabled if omitted")
+	cmd.Flags().BoolVar(&options.EnableCAPIClusters, "enable-capi-clusters", false, "Add provisioned Cluster API clusters found in the management cluster to the dashboard")
+	cmd.Flags().StringVar(&options.NamespaceAccessRulesConfigMap, "namespace-access-rules-configmap", "", fmt.Sprintf("Name of a ConfigMap in the server namespace whose %q key holds the permissions users need to see a namespace, and to see each kind of object in it, as YAML. Needs permission to get the ConfigMap. The built in rules are used if omitted", nsaccess.RulesConfigMapKey))
 	//  TLS
 	cmd.Flags().BoolVar(&options.Insecure, "insecure", false, "do not attempt to read TLS certificates")
 	cmd.Flags().BoolVar(&options.MTLS, "mtls", false, "disable enforce mTLS")
@@ -111,11 +141,27 @@ func NewCommand() *cobra.Command {
 	cmd.Flags().StringVar(&options.OIDC.IssuerURL, "oidc-issuer-url", "", "The URL of the OpenID Connect issuer")
 	cmd.Flags().StringVar(&options.OIDC.RedirectURL, "oidc-redirect-url", "", "The OAuth2 redirect URL")
 	cmd.Flags().DurationVar(&options.OIDC.TokenDuration, "oidc-token-duration", time.Hour, "The duration of the ID token. It should be set in the format: number + time unit (s,m,h) e.g., 20m")
-	cmd.Flags().StringVar(&options.OIDC.ClaimsConfig.Username, "oidc-username-claim", auth.ClaimUsername, "JWT claim to use as the user name. By default email, which is expected to be a unique identifier of the end user. Admins can choose other claims, such as sub or name, depending on their provider")
-	cmd.Flags().StringVar(&options.OIDC.ClaimsConfig.Groups, "oidc-groups-claim", auth.ClaimGroups, "JWT claim to use as the user's group. If the claim is present it must be an array of strings")
+	cmd.Flags().StringVar(&options.OIDC.ClaimsConfig.Username, "oidc-username-claim", auth.ClaimUsername, "JWT claim to use as the user name. By default email, which is expected to be a unique identifier of the end user. Admins can choose other claims, such as sub or name, depending on their provider. Nested claims can be given as a dot separated path")
+	cmd.Flags().StringVar(&options.OIDC.ClaimsConfig.Groups, "oidc-groups-claim", auth.ClaimGroups, "JWT claim to use as the user's group. If the claim is present it must be an array of strings, or a comma separated string. Nested claims can be given as a dot separated path, e.g. realm_access.roles")
+	cmd.Flags().StringVar(&options.OIDC.ClaimsConfig.GroupsPrefix, "oidc-groups-prefix", "", "Prefix added to every group from the OIDC provider before impersonating the user, e.g. oidc:")
+	cmd.Flags().StringSliceVar(&options.OIDC.ClaimsConfig.AllowedGroups, "oidc-allowed-groups", nil, "Shell patterns of the OIDC groups to use when impersonating the user, all groups are used if omitted")
+	cmd.Flags().StringSliceVar(&options.OIDC.ClaimsConfig.DeniedGroups, "oidc-denied-groups", nil, "Shell patterns of the OIDC groups never to use when impersonating the user, e.g. system:*")
 	cmd.Flags().StringSliceVar(&options.OIDC.Scopes, "custom-oidc-scopes", auth.DefaultScopes, "Customise the requested scopes for then OIDC authentication flow - openid will always be requested")
+	// Sessions
+	cmd.Flags().StringVar(&options.SessionStore, "session-store", sessionStoreCookie, "Where to keep the tokens of signed in users, one of: cookie (in the browser), memory (in the server, lost on restart), secret (in Secrets in the server's namespace, shared between replicas, needs permission to get, create, update, list and delete Secrets there. The Secrets hold refresh tokens, so don't let the dashboard view Secrets in that namespace, e.g. with the chart's rbac.viewSecrets)")
+	cmd.Flags().StringVar(&options.SigningKeys.SecretName, "signing-key-secret-name", "", "Name of the secret in the server's namespace holding the keys that sign local account tokens, so they survive restarts and are shared between replicas. It's created with a new key if it doesn't exist, which needs permission to create Secrets there. A random key is generated on every start if omitted")
+	cmd.Flags().StringVar(&options.SigningKeys.Algorithm, "signing-key-algorithm", auth.SigningAlgorithmHS256, fmt.Sprintf("The algorithm of the key generated when the signing key secret doesn't exist, one of: %s", strings.Join(auth.SigningAlgorithms(), ", ")))
+	cmd.Flags().IntVar(&options.LoginLockout.MaxAttempts, "login-max-attempts", auth.DefaultLoginMaxAttempts, "Lock a local account after this many failed sign ins in a row, 0 disables the lockout")
+	cmd.Flags().DurationVar(&options.LoginLockout.Duration, "login-lockout-duration", auth.DefaultLoginLockoutDuration, "How long a local account stays locked after too many failed sign ins")
+	// Audit
+	cmd.Flags().StringVar(&options.AuditLogFile, "audit-log-file", "", "Append an audit record of every sync, suspend, resume, sign in and sign out to this file, as JSON lines")
+	cmd.Flags().BoolVar(&options.AuditKubernetesEvents, "audit-kubernetes-events", false, "Record audit entries as Kubernetes Events")
+	// Tracing
+	cmd.Flags().StringVar(&options.Tracing.OTLPEndpoint, "tracing-otlp-endpoint", "", "host:port of an OTLP/HTTP collector to send traces of API calls to, including the calls made to each cluster. Tracing is disabled if omitted")
+	cmd.Flags().BoolVar(&options.Tracing.OTLPInsecure, "tracing-otlp-insecure", false, "Send traces to the collector over plain HTTP")
+	cmd.Flags().Float64Var(&options.Tracing.SampleRatio, "tracing-sample-ratio", 1, "Fraction of the traces started by the server to sample, between 0 and 1. Traces started by a caller follow the caller's sampling decision")
 	// Metrics
-	cmd.Flags().BoolVar(&options.EnableMetrics, "enable-metrics", false, "Starts the metrics listener")
+	cmd.Flags().BoolVar(&options.EnableMetrics, "enable-metrics", false, "Starts the metrics listener, which also reports core API latencies and the health of Flux objects on every cluster")
 	cmd.Flags().StringVar(&options.MetricsAddress, "metrics-address", ":2112", "If the metrics listener is enabled, bind to this address")
 
 	return cmd
@@ -131,6 +177,13 @@ func runCmd(cmd *cobra.Command, args []string) error {
 
 	featureflags.SetFromEnv(os.Environ())
 
+	options.Tracing.ServiceVersion = core.Version
+
+	shutdownTracing, err := tracing.Init(cmd.Context(), options.Tracing)
+	if err != nil {
+		return fmt.Errorf("could not initialise tracing: %w", err)
+	}
+
 	mux := http.NewServeMux()
 
 	mux.Handle("/health/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -172,12 +225,31 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("couldn't get current namespace")
 	}
 
-	authServer, err := auth.InitAuthServer(cmd.Context(), log, rawClient, options.OIDC, options.OIDCSecret, namespace, options.AuthMethods)
+	authServer, err := auth.InitAuthServer(cmd.Context(), log, rawClient, options.OIDC, options.OIDCSecret, namespace, options.AuthMethods, options.SigningKeys)
 
 	if err != nil {
 		return fmt.Errorf("could not initialise authentication server: %w", err)
 	}
 
+	auditSink, err := newAuditSink(rawClient, namespace)
+	if err != nil {
+		return fmt.Errorf("could not create audit sink: %w", err)
+	}
+
+	authServer.AuditSink = auditSink
+	authServer.LoginLockout = options.LoginLockout
+
+	sessionStore, err := newSessionStore(rawClient, namespace)
+	if err != nil {
+		return fmt.Errorf("could not create session store: %w", err)
+	}
+
+	if sessionStore != nil {
+		authServer.SessionStore = sessionStore
+
+		go pruneSessions(cmd.Context(), log, sessionStore)
+	}
+
 	log.Info("Registering auth routes")
 
 	if err := auth.RegisterAuthServer(mux, "/oauth2", authServer, loginRequestRateLimit); err != nil {
@@ -206,9 +278,38 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		cl = cluster.NewDelegatingCacheCluster(cl, rest, scheme)
 	}
 
-	fetcher := fetcher.NewSingleClusterFetcher(cl)
+	fetchers := []clustersmngr.ClusterFetcher{fetcher.NewSingleClusterFetcher(cl)}
 
-	clustersManager := clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{fetcher}, nsaccess.NewChecker(nsaccess.DefautltWegoAppRules), log)
+	if options.KubeconfigSecretsSelector != "" {
+		selector, err := labels.Parse(options.KubeconfigSecretsSelector)
+		if err != nil {
+			return fmt.Errorf("invalid kubeconfig secrets selector: %w", err)
+		}
+
+		watchClient, err := client.NewWithWatch(rest, client.Options{
+			Scheme: scheme,
+		})
+		if err != nil {
+			return fmt.Errorf("could not create kube watch client: %w", err)
+		}
+
+		log.Info("Discovering leaf clusters from kubeconfig secrets", "namespace", namespace, "selector", selector.String())
+
+		fetchers = append(fetchers, fetcher.NewSecretsClusterFetcher(watchClient, namespace, selector, scheme, log, cluster.DefaultKubeConfigOptions...))
+	}
+
+	if options.EnableCAPIClusters {
+		log.Info("Discovering leaf clusters from Cluster API clusters")
+
+		fetchers = append(fetchers, fetcher.NewCAPIClusterFetcher(rawClient, "", scheme, log, cluster.DefaultKubeConfigOptions...))
+	}
+
+	nsChecker, err := newNamespaceChecker(ctx, rawClient, namespace)
+	if err != nil {
+		return fmt.Errorf("could not load namespace access rules: %w", err)
+	}
+
+	clustersManager := clustersmngr.NewClustersManager(fetchers, nsChecker, log)
 	clustersManager.Start(ctx)
 
 	coreConfig, err := core.NewCoreConfig(log, rest, clusterName, clustersManager)
@@ -216,12 +317,24 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		return fmt.Errorf("could not create core config: %w", err)
 	}
 
-	appAndProfilesHandlers, err := server.NewHandlers(ctx, log,
-		&server.Config{
-			CoreServerConfig: coreConfig,
-			AuthServer:       authServer,
-		},
-	)
+	coreConfig.AuditSink = auditSink
+	coreConfig.NSAccess = nsChecker
+
+	serverConfig := &server.Config{
+		CoreServerConfig: coreConfig,
+		AuthServer:       authServer,
+	}
+
+	apiMetrics := prometheus.NewRegistry()
+
+	if options.EnableMetrics {
+		serverConfig.RPCMetrics, err = newAPIMetrics(ctx, apiMetrics, clustersManager, log)
+		if err != nil {
+			return fmt.Errorf("could not register api metrics: %w", err)
+		}
+	}
+
+	appAndProfilesHandlers, err := server.NewHandlers(ctx, log, serverConfig)
 	if err != nil {
 		return fmt.Errorf("could not create handler: %w", err)
 	}
@@ -250,6 +363,12 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		handler = httpmiddlewarestd.Handler("", mdlw, mux)
 	}
 
+	if options.Tracing.OTLPEndpoint != "" {
+		handler = otelhttp.NewHandler(handler, "gitops-server", otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "HTTP " + r.Method
+		}))
+	}
+
 	handler = middleware.WithLogging(log, handler)
 
 	addr := net.JoinHostPort(options.Host, options.Port)
@@ -275,6 +394,7 @@ func runCmd(cmd *cobra.Command, args []string) error {
 			prometheus.DefaultGatherer,
 			k8sMetrics.Registry,
 			clustersmngr.Registry,
+			apiMetrics,
 		}
 		metricsMux.Handle("/metrics", promhttp.HandlerFor(gatherers, promhttp.HandlerOpts{}))
 
@@ -313,9 +433,79 @@ func runCmd(cmd *cobra.Command, args []string) error {
 		}
 	}
 
+	if err := shutdownTracing(ctx); err != nil {
+		return fmt.Errorf("tracing shutdown failed: %w", err)
+	}
+
 	return nil
 }
 
+func newAuditSink(cl client.Client, namespace string) (audit.AuditSink, error) {
+	sinks := []audit.AuditSink{}
+
+	if options.AuditLogFile != "" {
+		fileSink, err := audit.NewFileSink(options.AuditLogFile)
+		if err != nil {
+			return nil, err
+		}
+
+		sinks = append(sinks, fileSink)
+	}
+
+	if options.AuditKubernetesEvents {
+		sinks = append(sinks, audit.NewEventSink(cl, namespace, cluster.DefaultCluster))
+	}
+
+	return audit.NewMultiSink(sinks...), nil
+}
+
+func newNamespaceChecker(ctx context.Context, cl client.Client, namespace string) (nsaccess.Checker, error) {
+	if options.NamespaceAccessRulesConfigMap == "" {
+		return nsaccess.NewChecker(nsaccess.DefautltWegoAppRules), nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := cl.Get(ctx, client.ObjectKey{Namespace: namespace, Name: options.NamespaceAccessRulesConfigMap}, cm); err != nil {
+		return nil, err
+	}
+
+	rules, err := nsaccess.RulesFromConfigMap(cm)
+	if err != nil {
+		return nil, err
+	}
+
+	return nsaccess.NewRulesChecker(rules), nil
+}
+
+func newSessionStore(cl client.Client, namespace string) (auth.SessionStore, error) {
+	switch options.SessionStore {
+	case sessionStoreCookie:
+		return nil, nil
+	case sessionStoreMemory:
+		return auth.NewMemorySessionStore(), nil
+	case sessionStoreSecret:
+		return auth.NewSecretSessionStore(cl, namespace), nil
+	}
+
+	return nil, fmt.Errorf("unknown session store %q, must be one of: %s, %s, %s", options.SessionStore, sessionStoreCookie, sessionStoreMemory, sessionStoreSecret)
+}
+
+func pruneSessions(ctx context.Context, log logr.Logger, store auth.SessionStore) {
+	ticker := time.NewTicker(sessionPruneInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := store.Prune(ctx); err != nil {
+				log.Error(err, "failed pruning expired sessions")
+			}
+		}
+	}
+}
+
 func listenAndServe(log logr.Logger, srv *http.Server, options Options) error {
 	if options.Insecure {
 		log.Info("TLS connections disabled")
@@ -400,3 +590,24 @@ func createRedirector(fsys fs.FS, log logr.Logger) http.HandlerFunc {
 		}
 	}
 }
+
+// newAPIMetrics registers the metrics for calls to the core API, and for the
+// health of the Flux objects on every cluster.
+func newAPIMetrics(ctx context.Context, reg prometheus.Registerer, clustersManager clustersmngr.ClustersManager, log logr.Logger) (*middleware.RPCMetrics, error) {
+	fluxMetrics := fluxmetrics.NewCollector(clustersManager, log)
+	if err := reg.Register(fluxMetrics); err != nil {
+		return nil, err
+	}
+
+	fluxMetrics.Start(ctx, fluxmetrics.DefaultRefreshInterval)
+
+	return middleware.NewRPCMetrics(reg, func(name string) bool {
+		for _, c := range clustersManager.GetClusters() {
+			if c.GetName() == name {
+				return true
+			}
+		}
+
+		return false
+	})
+}
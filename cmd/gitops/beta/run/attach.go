@@ -0,0 +1,92 @@
+package run
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/version"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/run/install"
+	"github.com/weaveworks/weave-gitops/pkg/run/session"
+)
+
+func AttachCommand(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "attach <session>",
+		Short: "Connect to a GitOps Run session that is already running",
+		Long:  "Connect to a GitOps Run session that is already running, for example after the connection was lost while your laptop was asleep, instead of creating it again. The command the session was started with is run again, so run this from the directory the session was started in.",
+		Example: `
+# Connect to the GitOps Run session "run-main-1234" again
+gitops beta run attach run-main-1234
+
+# Connect to a GitOps Run session in the dev namespace
+gitops beta run attach run-main-1234 --session-namespace dev`,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		PreRunE:           attachCommandPreRunE,
+		RunE:              attachCommandRunE(opts),
+		DisableAutoGenTag: true,
+	}
+
+	cmd.Flags().StringVar(&flags.SessionNamespace, "session-namespace", "default", "Specify the namespace of the session.")
+
+	return cmd
+}
+
+func attachCommandPreRunE(cmd *cobra.Command, args []string) error {
+	if len(args) == 0 {
+		return cmderrors.ErrSessionNameIsRequired
+	}
+
+	if len(args) > 1 {
+		return cmderrors.ErrMultipleNames
+	}
+
+	return nil
+}
+
+func attachCommandRunE(opts *config.Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		kubeClient, _, err := getKubeClient(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		sessionLog := logger.NewCLILogger(os.Stdout)
+
+		internalSession, err := session.Get(kubeClient, args[0], flags.SessionNamespace)
+		if err != nil {
+			return err
+		}
+
+		if internalSession.CliVersion != version.Version {
+			sessionLog.Warningf("Session %s was started by GitOps CLI %s, this is %s", internalSession.SessionName, internalSession.CliVersion, version.Version)
+		}
+
+		runSession, err := install.AttachSession(sessionLog, kubeClient, internalSession)
+		if err != nil {
+			return err
+		}
+
+		sessionLog.Actionf("Connecting to GitOps Run session %s ...", internalSession.SessionName)
+
+		// if the connection fails, the session is kept so it can be attached to again
+		if err := runSession.Connect(); err != nil {
+			return err
+		}
+
+		sessionLog.Println("")
+		sessionLog.Actionf("Deleting GitOps Run session %s ...", internalSession.SessionName)
+
+		if err := runSession.Close(); err != nil {
+			sessionLog.Failuref("Failed to delete session %s: %v", internalSession.SessionName, err)
+			return err
+		}
+
+		sessionLog.Successf("Session %s is deleted successfully", internalSession.SessionName)
+
+		return nil
+	}
+}
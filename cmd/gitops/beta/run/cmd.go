@@ -22,6 +22,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/internal/kubeclient"
 	"github.com/weaveworks/weave-gitops/pkg/fluxexec"
 	"github.com/weaveworks/weave-gitops/pkg/fluxinstall"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
@@ -33,10 +34,7 @@ import (
 	"github.com/weaveworks/weave-gitops/pkg/s3"
 	"github.com/weaveworks/weave-gitops/pkg/validate"
 	"github.com/weaveworks/weave-gitops/pkg/version"
-	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/types"
-	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
@@ -57,7 +55,7 @@ type RunCommandFlags struct {
 	Components        []string
 	ComponentsExtra   []string
 	Timeout           time.Duration
-	PortForward       string // port forward specifier, e.g. "port=8080:8080,resource=svc/app"
+	PortForwards      []string // port forward specifiers, e.g. "port=8080:8080,resource=svc/app"
 	RootDir           string
 	DecryptionKeyFile string
 
@@ -74,12 +72,7 @@ type RunCommandFlags struct {
 	SkipResourceCleanup bool
 	NoBootstrap         bool
 
-	// Global flags.
-	Namespace  string
-	KubeConfig string
-
-	// Flags, created by genericclioptions.
-	Context string
+	kubeclient.Flags
 
 	// Hidden session name for the sub-process
 	HiddenSessionName string
@@ -93,7 +86,7 @@ func RunCommand(opts *config.Options) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "run",
 		Short: "Set up an interactive sync between your cluster and your local file system",
-		Long:  "This will set up a sync between the cluster in your kubeconfig and the path that you specify on your local filesystem.  If you do not have Flux installed on the cluster then this will add it to the cluster automatically.  This is a requirement so we can sync the files successfully from your local system onto the cluster.  Flux will take care of producing the objects for you.",
+		Long:  "This will set up a sync between the cluster in your kubeconfig and the path that you specify on your local filesystem.  If you do not have Flux installed on the cluster then this will add it to the cluster automatically.  This is a requirement so we can sync the files successfully from your local system onto the cluster.  Flux will take care of producing the objects for you.  Settings for a project can be kept in a " + run.ConfigFileName + " file in the target directory or at the root of the git repository, flags set on the command line override them.",
 		Example: `
 # Run the sync on the current working directory
 gitops beta run . [flags]
@@ -105,6 +98,9 @@ gitops beta run ./deploy/overlays/dev
 # Listen on port 8080 on localhost, forwarding to 5000 in a pod of the service app.
 gitops beta run ./dev --port-forward port=8080:5000,resource=svc/app
 
+# Run the sync on the dev directory and forward the ports of both the frontend and the backend.
+gitops beta run ./dev --port-forward port=8080:80,resource=svc/frontend --port-forward port=9898:9898,resource=svc/backend,name=api
+
 # Run the sync on the dev directory with a specified root dir.
 gitops beta run ./clusters/default/dev --root-dir ./clusters/default
 
@@ -136,7 +132,7 @@ gitops beta run ./chart/podinfo --timeout 3m --port-forward namespace=flux-syste
 	cmdFlags.StringSliceVar(&flags.Components, "components", []string{"source-controller", "kustomize-controller", "helm-controller", "notification-controller"}, "The Flux components to install.")
 	cmdFlags.StringSliceVar(&flags.ComponentsExtra, "components-extra", []string{}, "Additional Flux components to install, allowed values are image-reflector-controller,image-automation-controller.")
 	cmdFlags.DurationVar(&flags.Timeout, "timeout", 5*time.Minute, "The timeout for operations during GitOps Run.")
-	cmdFlags.StringVar(&flags.PortForward, "port-forward", "", "Forward the port from a cluster's resource to your local machine i.e. 'port=8080:8080,resource=svc/app'.")
+	cmdFlags.StringArrayVar(&flags.PortForwards, "port-forward", []string{}, "Forward the port from a cluster's resource to your local machine i.e. 'port=8080:8080,resource=svc/app,name=app'. Can be repeated, more port forwards can be listed in "+run.ConfigFileName+".")
 	cmdFlags.StringVar(&flags.DashboardPort, "dashboard-port", "9001", "GitOps Dashboard port")
 	cmdFlags.BoolVar(&flags.SkipDashboardInstall, "skip-dashboard-install", false, "Skip installation of the Dashboard. This also disables the prompt asking whether the Dashboard should be installed.")
 	cmdFlags.StringVar(&flags.DashboardHashedPassword, "dashboard-hashed-password", "", "GitOps Dashboard password in BCrypt hash format")
@@ -156,7 +152,10 @@ gitops beta run ./chart/podinfo --timeout 3m --port-forward namespace=flux-syste
 
 	kubeConfigArgs = run.GetKubeConfigArgs()
 
-	kubeConfigArgs.AddFlags(cmd.Flags())
+	// the kube config flags are shared with the session subcommands
+	kubeConfigArgs.AddFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(AttachCommand(opts))
 
 	return cmd
 }
@@ -206,61 +205,11 @@ func betaRunCommandPreRunE(endpoint *string) func(*cobra.Command, []string) erro
 }
 
 func getKubeClient(cmd *cobra.Command, args []string) (*kube.KubeHTTP, *rest.Config, error) {
-	var err error
-
 	log := logger.NewCLILogger(os.Stdout)
 
-	if flags.Namespace, err = cmd.Flags().GetString("namespace"); err != nil {
-		return nil, nil, err
-	}
-
-	kubeConfigArgs.Namespace = &flags.Namespace
-
-	if flags.KubeConfig, err = cmd.Flags().GetString("kubeconfig"); err != nil {
-		return nil, nil, err
-	}
-
-	if flags.Context, err = cmd.Flags().GetString("context"); err != nil {
-		return nil, nil, err
-	}
-
-	if flags.KubeConfig != "" {
-		kubeConfigArgs.KubeConfig = &flags.KubeConfig
-
-		if flags.Context == "" {
-			log.Failuref("A context should be provided if a kubeconfig is provided")
-			return nil, nil, cmderrors.ErrNoContextForKubeConfig
-		}
-	}
-
 	log.Actionf("Checking for a cluster in the kube config ...")
 
-	var contextName string
-
-	if flags.Context != "" {
-		contextName = flags.Context
-	} else {
-		_, contextName, err = kube.RestConfig()
-		if err != nil {
-			log.Failuref("Error getting a restconfig: %v", err.Error())
-			return nil, nil, cmderrors.ErrNoCluster
-		}
-	}
-
-	cfg, err := kubeConfigArgs.ToRESTConfig()
-	if err != nil {
-		return nil, nil, fmt.Errorf("error getting a restconfig from kube config args: %w", err)
-	}
-
-	kubeClientOpts := run.GetKubeClientOptions()
-	kubeClientOpts.BindFlags(cmd.Flags())
-
-	kubeClient, err := run.GetKubeClient(log, contextName, cfg, kubeClientOpts)
-	if err != nil {
-		return nil, nil, cmderrors.ErrGetKubeClient
-	}
-
-	return kubeClient, cfg, nil
+	return kubeclient.GetKubeClient(log, cmd, kubeConfigArgs, &flags.Flags)
 }
 
 func fluxStep(log logger.Logger, kubeClient *kube.KubeHTTP) (fluxVersion *install.FluxVersionInfo, justInstalled bool, err error) {
@@ -427,7 +376,7 @@ func dashboardStep(ctx context.Context, log logger.Logger, kubeClient *kube.Kube
 	return dashboardInstalled, dashboardManifests, "", nil
 }
 
-func runCommandWithSession(cmd *cobra.Command, args []string) (retErr error) {
+func runCommandWithSession(cmd *cobra.Command, args []string, runConfig *run.Config) (retErr error) {
 	paths, err := run.NewPaths(args[0], flags.RootDir)
 	if err != nil {
 		return err
@@ -471,12 +420,12 @@ func runCommandWithSession(cmd *cobra.Command, args []string) (retErr error) {
 
 	portForwardsForSession := []string{flags.DashboardPort}
 
-	if flags.PortForward != "" {
-		spec, err := watch.ParsePortForwardSpec(flags.PortForward)
-		if err != nil {
-			return err
-		}
+	portForwardSpecs, err := watch.ParsePortForwardSpecs(flags.PortForwards, runConfig)
+	if err != nil {
+		return err
+	}
 
+	for _, spec := range portForwardSpecs {
 		portForwardsForSession = append(portForwardsForSession, spec.HostPort)
 	}
 
@@ -567,7 +516,7 @@ func runCommandWithSession(cmd *cobra.Command, args []string) (retErr error) {
 	return err
 }
 
-func runCommandWithoutSession(cmd *cobra.Command, args []string) error {
+func runCommandWithoutSession(cmd *cobra.Command, args []string, runConfig *run.Config) error {
 	// There are two loggers in this function.
 	// 1. log0 is the os.Stdout logger
 	// 2. log is the S3 logger that also delegates its outputs to "log0".
@@ -578,6 +527,11 @@ func runCommandWithoutSession(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	portForwardSpecs, err := watch.ParsePortForwardSpecs(flags.PortForwards, runConfig)
+	if err != nil {
+		return err
+	}
+
 	kubeClient, cfg, err := getKubeClient(cmd, args)
 	if err != nil {
 		return err
@@ -769,7 +723,7 @@ func runCommandWithoutSession(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
-	ignorer := watch.CreateIgnorer(paths.RootDir)
+	ignorer := watch.CreateIgnorer(paths.RootDir, runConfig.Ignore...)
 
 	err = filepath.Walk(paths.RootDir, watch.WatchDirsForFileWalker(watcher, ignorer))
 	if err != nil {
@@ -777,6 +731,8 @@ func runCommandWithoutSession(cmd *cobra.Command, args []string) error {
 		return err
 	}
 
+	syncer := watch.NewDirSyncer(log, paths.RootDir, watch.RunDevBucketName, minioClient, ignorer)
+
 	// cancel function to stop forwarding port
 	var (
 		cancelPortFwd func()
@@ -788,6 +744,7 @@ func runCommandWithoutSession(cmd *cobra.Command, args []string) error {
 	watcherCtx, watcherCancel := context.WithCancel(ctx)
 	lastReconcile := time.Now()
 	stopUploadCh := make(chan struct{})
+	batches := watch.BatchEvents(ctx, watcher.Events, ignorer, 300*time.Millisecond, 3*time.Second)
 
 	go func() {
 		for {
@@ -796,13 +753,19 @@ func runCommandWithoutSession(cmd *cobra.Command, args []string) error {
 				return
 			case <-stopUploadCh:
 				return
-			case event := <-watcher.Events:
-				if event.Op&fsnotify.Create == fsnotify.Create ||
-					event.Op&fsnotify.Remove == fsnotify.Remove ||
-					event.Op&fsnotify.Rename == fsnotify.Rename {
-					// if it's a dir, we need to watch it
-					if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
-						needToRescan = true
+			case batch, ok := <-batches:
+				if !ok {
+					return
+				}
+
+				for _, event := range batch {
+					if event.Op&fsnotify.Create == fsnotify.Create ||
+						event.Op&fsnotify.Remove == fsnotify.Remove ||
+						event.Op&fsnotify.Rename == fsnotify.Rename {
+						// if it's a dir, we need to watch it
+						if info, err := os.Stat(event.Name); err == nil && info.IsDir() {
+							needToRescan = true
+						}
 					}
 				}
 
@@ -817,7 +780,7 @@ func runCommandWithoutSession(cmd *cobra.Command, args []string) error {
 					watcherCtx, watcherCancel = context.WithCancel(ctx)
 				}
 
-				atomic.AddUint64(&counter, 1)
+				atomic.AddUint64(&counter, uint64(len(batch)))
 			case err := <-watcher.Errors:
 				if err != nil {
 					log.Failuref("Error: %v", err)
@@ -853,21 +816,14 @@ func runCommandWithoutSession(cmd *cobra.Command, args []string) error {
 					}
 
 					// use ctx, not thisCtx - incomplete uploads will never make anybody happy
-					if err := watch.SyncDir(ctx, log, paths.RootDir, watch.RunDevBucketName, minioClient, ignorer); err != nil {
+					if err := syncer.Sync(ctx); err != nil {
 						log.Failuref("Error syncing dir: %v", err)
 					}
 
 					if needToRescan {
-						// close the old watcher
-						if err := watcher.Close(); err != nil {
-							log.Warningf("Error closing the old watcher: %v", err)
-						}
-						// create a new watcher
-						watcher, err = fsnotify.NewWatcher()
-						if err != nil {
-							log.Failuref("Error creating new watcher: %v", err)
-						}
-
+						// Watch the new directories too. Removed directories
+						// are dropped by the watcher, and the ones it's
+						// already watching are left as they are.
 						err = filepath.Walk(paths.RootDir, watch.WatchDirsForFileWalker(watcher, ignorer))
 						if err != nil {
 							log.Failuref("Error re-walking dir: %v", err)
@@ -912,27 +868,16 @@ func runCommandWithoutSession(cmd *cobra.Command, args []string) error {
 						}
 					}
 
-					var specMap *watch.PortForwardSpec
-
-					if flags.PortForward != "" {
-						specMap, err = watch.ParsePortForwardSpec(flags.PortForward)
+					for _, spec := range portForwardSpecs {
+						portForwardKey, err := watch.GetNextPortForwardKey(portForwards)
 						if err != nil {
-							log.Failuref("Error parsing port forward spec: %v", err)
-						} else {
-							serviceName := specMap.Name
-							if serviceName == "" {
-								serviceName = "service"
-							}
+							log.Failuref("Error adding a port forward: %v", err)
+							continue
+						}
 
-							portForwardKey, err := watch.GetNextPortForwardKey(portForwards)
-							if err != nil {
-								log.Failuref("Error adding a port forward: %v", err)
-							} else {
-								portForwards[portForwardKey] = watch.PortForwardShortcut{
-									Name:     serviceName,
-									HostPort: specMap.HostPort,
-								}
-							}
+						portForwards[portForwardKey] = watch.PortForwardShortcut{
+							Name:     spec.DisplayName(),
+							HostPort: spec.HostPort,
 						}
 					}
 
@@ -940,46 +885,18 @@ func runCommandWithoutSession(cmd *cobra.Command, args []string) error {
 						watch.ShowPortForwards(ctx, log, portForwards)
 					}
 
-					if specMap != nil {
-						// get pod from specMap
-						namespacedName := types.NamespacedName{Namespace: specMap.Namespace, Name: specMap.Name}
-
-						var (
-							pod    *corev1.Pod
-							podErr error
-						)
+					if len(portForwardSpecs) > 0 {
+						// the port forwards are stopped when files change, and
+						// started again once the changes are reconciled.
+						fwdCtx, fwdCancel := context.WithCancel(ctx)
+						cancelPortFwd = func() {
+							fwdCancel()
 
-						if pollErr := wait.PollImmediate(2*time.Second, flags.Timeout, func() (bool, error) {
-							pod, podErr = run.GetPodFromResourceDescription(thisCtx, namespacedName, specMap.Kind, kubeClient)
-							if pod != nil && podErr == nil {
-								return true, nil
-							}
-
-							log.Waitingf("Waiting for a pod from specMap: %v", podErr)
-							return false, nil
-						}); pollErr != nil {
-							log.Failuref("Waiting for a pod from specMap: %v", pollErr)
+							cancelPortFwd = nil
 						}
 
-						if pod == nil {
-							log.Failuref("Error getting pod from specMap")
-						} else /* pod is available */ {
-							waitFwd := make(chan struct{}, 1)
-							readyChannel := make(chan struct{})
-							cancelPortFwd = func() {
-								close(waitFwd)
-
-								cancelPortFwd = nil
-							}
-
-							log.Actionf("Port forwarding to pod %s/%s ...", pod.Namespace, pod.Name)
-
-							// this function _BLOCKS_ until the stopChannel (waitPwd) is closed.
-							if err := watch.ForwardPort(log.L(), pod, cfg, specMap, waitFwd, readyChannel); err != nil {
-								log.Failuref("Error forwarding port: %v", err)
-							}
-
-							log.Successf("Port forwarding is stopped.")
+						for _, spec := range portForwardSpecs {
+							go watch.KeepPortForwarding(fwdCtx, log, kubeClient, cfg, spec, 2*time.Second)
 						}
 					}
 				}
@@ -1247,10 +1164,22 @@ func runBootstrap(ctx context.Context, log logger.Logger, paths *run.Paths, mani
 
 func betaRunCommandRunE(opts *config.Options) func(*cobra.Command, []string) error {
 	return func(cmd *cobra.Command, args []string) error {
+		paths, err := run.NewPaths(args[0], flags.RootDir)
+		if err != nil {
+			return err
+		}
+
+		runConfig, configDir, err := run.LoadConfig(paths.GetAbsoluteTargetDir(), paths.RootDir)
+		if err != nil {
+			return err
+		}
+
+		applyConfig(cmd.Flags(), runConfig, configDir)
+
 		if flags.NoSession {
-			return runCommandWithoutSession(cmd, args)
+			return runCommandWithoutSession(cmd, args, runConfig)
 		} else {
-			return runCommandWithSession(cmd, args)
+			return runCommandWithSession(cmd, args, runConfig)
 		}
 	}
 }
@@ -0,0 +1,52 @@
+package run
+
+import (
+	"path/filepath"
+
+	"github.com/spf13/pflag"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+)
+
+// applyConfig sets the flags that weren't set on the command line from the
+// configuration file, so the flags override the file. Relative paths in the
+// file are relative to the directory it's in.
+func applyConfig(flagSet *pflag.FlagSet, config *run.Config, configDir string) {
+	notSet := func(name string) bool {
+		return !flagSet.Changed(name)
+	}
+
+	if len(config.Components) > 0 && notSet("components") {
+		flags.Components = config.Components
+	}
+
+	if len(config.ComponentsExtra) > 0 && notSet("components-extra") {
+		flags.ComponentsExtra = config.ComponentsExtra
+	}
+
+	if config.Timeout != nil && notSet("timeout") {
+		flags.Timeout = config.Timeout.Duration
+	}
+
+	if config.DecryptionKeyFile != "" && notSet("decryption-key-file") {
+		flags.DecryptionKeyFile = config.DecryptionKeyFile
+		if !filepath.IsAbs(flags.DecryptionKeyFile) {
+			flags.DecryptionKeyFile = filepath.Join(configDir, flags.DecryptionKeyFile)
+		}
+	}
+
+	if config.SessionNamespace != "" && notSet("session-namespace") {
+		flags.SessionNamespace = config.SessionNamespace
+	}
+
+	if config.Dashboard.Port != "" && notSet("dashboard-port") {
+		flags.DashboardPort = config.Dashboard.Port
+	}
+
+	if config.Dashboard.HashedPassword != "" && notSet("dashboard-hashed-password") {
+		flags.DashboardHashedPassword = config.Dashboard.HashedPassword
+	}
+
+	if config.Dashboard.SkipInstall && notSet("skip-dashboard-install") {
+		flags.SkipDashboardInstall = true
+	}
+}
@@ -0,0 +1,49 @@
+package run
+
+import (
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplyConfigOnlySetsFlagsThatWerentSet(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cmd := RunCommand(&config.Options{})
+	g.Expect(cmd.ParseFlags([]string{"--timeout", "1m", "--dashboard-port", "9002"})).To(Succeed())
+
+	applyConfig(cmd.Flags(), &run.Config{
+		Components:        []string{"source-controller", "kustomize-controller"},
+		Timeout:           &metav1.Duration{Duration: 10 * time.Minute},
+		DecryptionKeyFile: "age.key",
+		SessionNamespace:  "dev",
+		Dashboard: run.DashboardConfig{
+			Port:        "9003",
+			SkipInstall: true,
+		},
+	}, "/projects/app")
+
+	g.Expect(flags.Components).To(Equal([]string{"source-controller", "kustomize-controller"}))
+	g.Expect(flags.ComponentsExtra).To(BeEmpty())
+	g.Expect(flags.Timeout).To(Equal(time.Minute))
+	g.Expect(flags.DecryptionKeyFile).To(Equal("/projects/app/age.key"))
+	g.Expect(flags.SessionNamespace).To(Equal("dev"))
+	g.Expect(flags.DashboardPort).To(Equal("9002"))
+	g.Expect(flags.DashboardHashedPassword).To(BeEmpty())
+	g.Expect(flags.SkipDashboardInstall).To(BeTrue())
+}
+
+func TestApplyConfigKeepsAbsolutePaths(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cmd := RunCommand(&config.Options{})
+	g.Expect(cmd.ParseFlags([]string{})).To(Succeed())
+
+	applyConfig(cmd.Flags(), &run.Config{DecryptionKeyFile: "/keys/age.key"}, "/projects/app")
+
+	g.Expect(flags.DecryptionKeyFile).To(Equal("/keys/age.key"))
+}
@@ -1,30 +1,127 @@
 package check
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"os"
 
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/run"
 	"github.com/weaveworks/weave-gitops/pkg/services/check"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
 
-	"github.com/spf13/cobra"
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// ErrChecksFailed makes the command exit non-zero when any check failed.
+var ErrChecksFailed = errors.New("some checks failed")
+
+type checkFlags struct {
+	Output                        string
+	NamespaceAccessRulesConfigMap string
+}
+
+var (
+	flags          checkFlags
+	kubeConfigArgs *genericclioptions.ConfigFlags
 )
 
-var Cmd = &cobra.Command{
-	Use:   "check",
-	Short: "Validates flux compatibility",
-	Example: `
-# Validate flux and kubernetes compatibility
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check",
+		Short: "Validates that a cluster is ready to run Weave GitOps",
+		Long: `Validates the Kubernetes version, that the Flux controllers are installed and healthy,
+that the Flux CRDs are compatible, that the gitops-server and its authentication secrets are
+installed in the namespace, and that the current user has the permissions Weave GitOps needs
+there. Exits non-zero if any of the checks fail. Until the gitops-server is installed, the
+gitops-server, authentication and permission checks only warn.`,
+		Example: `
+# Validate the cluster Weave GitOps is installed in
 gitops check
+
+# Validate the cluster, reporting the results as JSON for CI
+gitops check --namespace flux-system --output json
+
+# Validate the permissions the gitops-server's own namespace access rules require
+gitops check --namespace-access-rules-configmap weave-gitops-namespace-access
 `,
-	RunE: runCmd,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		RunE:              checkCommandRunE,
+		DisableAutoGenTag: true,
+	}
+
+	cmd.Flags().StringVarP(&flags.Output, "output", "o", outputText, "Output format, one of: text, json")
+	cmd.Flags().StringVar(&flags.NamespaceAccessRulesConfigMap, "namespace-access-rules-configmap", "", "Name of the ConfigMap in the namespace the gitops-server reads its namespace access rules from, if it was given one with the flag of the same name. The built in rules are checked if omitted")
+
+	kubeConfigArgs = run.GetKubeConfigArgs()
+	kubeConfigArgs.AddFlags(cmd.Flags())
+	kubeConfigArgs.KubeConfig = &opts.Kubeconfig
+
+	return cmd
 }
 
-func runCmd(_ *cobra.Command, _ []string) error {
-	output, err := check.Pre()
+func checkCommandRunE(cmd *cobra.Command, args []string) error {
+	if flags.Output != outputText && flags.Output != outputJSON {
+		return fmt.Errorf("unsupported output format %q, must be one of: text, json", flags.Output)
+	}
+
+	namespace, err := cmd.Flags().GetString("namespace")
 	if err != nil {
 		return err
 	}
 
-	fmt.Println(output)
+	cfg, err := kubeConfigArgs.ToRESTConfig()
+	if err != nil {
+		return fmt.Errorf("getting kubeconfig: %w", err)
+	}
+
+	clientset, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("creating clientset: %w", err)
+	}
+
+	scheme, err := kube.CreateScheme()
+	if err != nil {
+		return err
+	}
+
+	kubeClient, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		return fmt.Errorf("creating kubernetes client: %w", err)
+	}
+
+	checker := check.Checker{
+		Discovery:                     clientset.Discovery(),
+		Client:                        kubeClient,
+		Auth:                          clientset.AuthorizationV1(),
+		Namespace:                     namespace,
+		NamespaceAccessRulesConfigMap: flags.NamespaceAccessRulesConfigMap,
+	}
+
+	report := checker.Run(context.Background())
+
+	if flags.Output == outputJSON {
+		err = check.PrintJSON(os.Stdout, report)
+	} else {
+		err = check.PrintText(os.Stdout, report)
+	}
+
+	if err != nil {
+		return err
+	}
+
+	if !report.Passed {
+		return ErrChecksFailed
+	}
 
 	return nil
 }
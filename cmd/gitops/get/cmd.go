@@ -6,6 +6,8 @@ import (
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/get/bcrypt"
 	configCmd "github.com/weaveworks/weave-gitops/cmd/gitops/get/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/get/objects"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/get/run"
 )
 
 func GetCommand(opts *config.Options) *cobra.Command {
@@ -18,11 +20,25 @@ gitops get config
 
 # Generate a hashed secret
 PASSWORD="<your password>"
-echo -n $PASSWORD | gitops get bcrypt-hash`,
+echo -n $PASSWORD | gitops get bcrypt-hash
+
+# List the Kustomizations in every namespace of every cluster
+gitops get kustomizations -A --endpoint https://gitops.example.com --username admin --password <password>
+
+# List the events of a HelmRelease
+gitops get events helmrelease/podinfo -n apps
+
+# List the GitOps Run sessions
+gitops get run-sessions`,
 	}
 
 	cmd.AddCommand(bcrypt.HashCommand(opts))
 	cmd.AddCommand(configCmd.ConfigCommand(opts))
+	cmd.AddCommand(objects.KustomizationsCommand(opts))
+	cmd.AddCommand(objects.HelmReleasesCommand(opts))
+	cmd.AddCommand(objects.SourcesCommand(opts))
+	cmd.AddCommand(objects.EventsCommand(opts))
+	cmd.AddCommand(run.RunSessionsCommand(opts))
 
 	return cmd
 }
@@ -0,0 +1,240 @@
+package objects
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/coreclient"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+)
+
+type getFlags struct {
+	Output        string
+	Cluster       string
+	AllNamespaces bool
+}
+
+var flags getFlags
+
+func KustomizationsCommand(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "kustomizations",
+		Aliases: []string{"kustomization", "ks"},
+		Short:   "List Flux Kustomizations through the Weave GitOps server",
+		Example: `
+# List the Kustomizations in the flux-system namespace of every cluster
+gitops get kustomizations --endpoint https://gitops.example.com --username admin --password <password>
+
+# List the Kustomizations in every namespace of one cluster, as YAML
+gitops get kustomizations -A --cluster Default -o yaml`,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		PreRunE:           getObjectsCommandPreRunE(&opts.Endpoint),
+		RunE:              getObjectsCommandRunE(opts, kustomizev1.KustomizationKind),
+		DisableAutoGenTag: true,
+	}
+
+	addListFlags(cmd)
+
+	return cmd
+}
+
+func HelmReleasesCommand(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "helmreleases",
+		Aliases: []string{"helmrelease", "hr"},
+		Short:   "List Flux HelmReleases through the Weave GitOps server",
+		Example: `
+# List the HelmReleases in the flux-system namespace of every cluster
+gitops get helmreleases --endpoint https://gitops.example.com --username admin --password <password>
+
+# List the HelmReleases in the apps namespace as JSON
+gitops get helmreleases -n apps -o json`,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		PreRunE:           getObjectsCommandPreRunE(&opts.Endpoint),
+		RunE:              getObjectsCommandRunE(opts, helmv2.HelmReleaseKind),
+		DisableAutoGenTag: true,
+	}
+
+	addListFlags(cmd)
+
+	return cmd
+}
+
+func SourcesCommand(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "sources",
+		Aliases: []string{"source"},
+		Short:   "List Flux sources through the Weave GitOps server",
+		Example: `
+# List every kind of Flux source in every namespace
+gitops get sources -A --endpoint https://gitops.example.com --username admin --password <password>`,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		PreRunE:           getObjectsCommandPreRunE(&opts.Endpoint),
+		RunE:              getObjectsCommandRunE(opts, coreclient.SourceKinds...),
+		DisableAutoGenTag: true,
+	}
+
+	addListFlags(cmd)
+
+	return cmd
+}
+
+func EventsCommand(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "events <kind>/<name>",
+		Aliases: []string{"event"},
+		Short:   "List the Kubernetes events of a Flux object through the Weave GitOps server",
+		Example: `
+# List the events of the flux-system Kustomization
+gitops get events kustomization/flux-system --endpoint https://gitops.example.com --username admin --password <password>
+
+# List the events of a HelmRelease on a leaf cluster
+gitops get events hr/podinfo -n apps --cluster dev/leaf`,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		PreRunE:           getEventsCommandPreRunE(&opts.Endpoint),
+		RunE:              getEventsCommandRunE(opts),
+		DisableAutoGenTag: true,
+	}
+
+	cmd.Flags().StringVarP(&flags.Output, "output", "o", outputTable, "Output format, one of: table, json, yaml")
+	cmd.Flags().StringVar(&flags.Cluster, "cluster", "", "The cluster the object is in")
+
+	return cmd
+}
+
+func addListFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVarP(&flags.Output, "output", "o", outputTable, "Output format, one of: table, json, yaml")
+	cmd.Flags().StringVar(&flags.Cluster, "cluster", "", "Only list objects in this cluster")
+	cmd.Flags().BoolVarP(&flags.AllNamespaces, "all-namespaces", "A", false, "List objects in every namespace")
+}
+
+func getObjectsCommandPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if *endpoint == "" {
+			return cmderrors.ErrNoEndpoint
+		}
+
+		if len(args) > 0 {
+			return cmderrors.ErrInvalidArgs
+		}
+
+		return validateOutput(flags.Output)
+	}
+}
+
+func getEventsCommandPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if *endpoint == "" {
+			return cmderrors.ErrNoEndpoint
+		}
+
+		if len(args) != 1 {
+			return fmt.Errorf("exactly one <kind>/<name> argument is required")
+		}
+
+		return validateOutput(flags.Output)
+	}
+}
+
+func getObjectsCommandRunE(opts *config.Options, kinds ...string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		if flags.AllNamespaces {
+			namespace = ""
+		}
+
+		client, err := newClient(opts)
+		if err != nil {
+			return err
+		}
+
+		log := logger.NewCLILogger(os.Stderr)
+
+		rows := []objectRow{}
+
+		for _, kind := range kinds {
+			res, err := client.ListObjects(context.Background(), &pb.ListObjectsRequest{
+				Kind:        kind,
+				Namespace:   namespace,
+				ClusterName: flags.Cluster,
+			})
+			if err != nil {
+				return fmt.Errorf("listing %s objects: %w", kind, err)
+			}
+
+			// Clusters that couldn't be reached are reported, but don't stop
+			// the others from being listed, just like in the dashboard.
+			for _, listErr := range res.Errors {
+				log.Warningf("%s: %s", listErr.ClusterName, listErr.Message)
+			}
+
+			for _, obj := range res.Objects {
+				row, err := newObjectRow(kind, obj)
+				if err != nil {
+					return err
+				}
+
+				rows = append(rows, row)
+			}
+		}
+
+		return printObjects(os.Stdout, flags.Output, len(kinds) > 1, rows)
+	}
+}
+
+func getEventsCommandRunE(opts *config.Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		kind, name, err := coreclient.ParseKindName(args[0])
+		if err != nil {
+			return err
+		}
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		client, err := newClient(opts)
+		if err != nil {
+			return err
+		}
+
+		res, err := client.ListEvents(context.Background(), &pb.ListEventsRequest{
+			InvolvedObject: &pb.ObjectRef{
+				Kind:        kind,
+				Name:        name,
+				Namespace:   namespace,
+				ClusterName: flags.Cluster,
+			},
+		})
+		if err != nil {
+			return fmt.Errorf("listing events: %w", err)
+		}
+
+		return printEvents(os.Stdout, flags.Output, res.Events)
+	}
+}
+
+func newClient(opts *config.Options) (*coreclient.Client, error) {
+	return coreclient.New(coreclient.Options{
+		Endpoint:              opts.Endpoint,
+		Username:              opts.Username,
+		Password:              opts.Password,
+		InsecureSkipTLSVerify: opts.InsecureSkipTLSVerify,
+	})
+}
@@ -0,0 +1,160 @@
+package objects
+
+import (
+	"bytes"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+func TestGetObjectsCommandPreRunE(t *testing.T) {
+	tests := []struct {
+		name     string
+		endpoint string
+		args     []string
+		output   string
+		err      error
+		errMsg   string
+	}{
+		{
+			name:   "without endpoint",
+			output: outputTable,
+			err:    cmderrors.ErrNoEndpoint,
+		},
+		{
+			name:     "with arguments",
+			endpoint: "https://gitops.example.com",
+			args:     []string{"podinfo"},
+			output:   outputTable,
+			err:      cmderrors.ErrInvalidArgs,
+		},
+		{
+			name:     "with unsupported output",
+			endpoint: "https://gitops.example.com",
+			output:   "csv",
+			errMsg:   `unsupported output format "csv"`,
+		},
+		{
+			name:     "valid",
+			endpoint: "https://gitops.example.com",
+			output:   outputYAML,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			flags = getFlags{Output: tt.output}
+
+			err := getObjectsCommandPreRunE(&tt.endpoint)(nil, tt.args)
+
+			switch {
+			case tt.err != nil:
+				g.Expect(err).To(MatchError(tt.err))
+			case tt.errMsg != "":
+				g.Expect(err).To(MatchError(ContainSubstring(tt.errMsg)))
+			default:
+				g.Expect(err).NotTo(HaveOccurred())
+			}
+		})
+	}
+}
+
+func TestGetEventsCommandPreRunE(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	flags = getFlags{Output: outputTable}
+
+	endpoint := ""
+	g.Expect(getEventsCommandPreRunE(&endpoint)(nil, []string{"ks/podinfo"})).To(MatchError(cmderrors.ErrNoEndpoint))
+
+	endpoint = "https://gitops.example.com"
+	g.Expect(getEventsCommandPreRunE(&endpoint)(nil, nil)).To(MatchError(ContainSubstring("exactly one <kind>/<name> argument")))
+	g.Expect(getEventsCommandPreRunE(&endpoint)(nil, []string{"ks/podinfo"})).To(Succeed())
+}
+
+func TestNewObjectRow(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	row, err := newObjectRow("Kustomization", &pb.Object{
+		ClusterName: "Default",
+		Tenant:      "team-a",
+		Payload: `{
+			"metadata": {"name": "podinfo", "namespace": "apps"},
+			"spec": {"suspend": true},
+			"status": {"conditions": [
+				{"type": "Healthy", "status": "False", "message": "not this one"},
+				{"type": "Ready", "status": "False", "message": "build failed"}
+			]}
+		}`,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(row.ClusterName).To(Equal("Default"))
+	g.Expect(row.Tenant).To(Equal("team-a"))
+	g.Expect(row.Namespace).To(Equal("apps"))
+	g.Expect(row.Name).To(Equal("podinfo"))
+	g.Expect(row.Suspended).To(BeTrue())
+	g.Expect(row.Ready).To(Equal("False"))
+	g.Expect(row.Message).To(Equal("build failed"))
+
+	row, err = newObjectRow("Kustomization", &pb.Object{Payload: `{"metadata": {"name": "new"}}`})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(row.Ready).To(Equal("Unknown"))
+
+	_, err = newObjectRow("Kustomization", &pb.Object{ClusterName: "Default", Payload: "not json"})
+	g.Expect(err).To(MatchError(ContainSubstring("decoding Kustomization from cluster Default")))
+}
+
+func TestPrintObjects(t *testing.T) {
+	rows := []objectRow{
+		{ClusterName: "Default", Kind: "GitRepository", Namespace: "flux-system", Name: "flux-system", Ready: "True", Message: "stored artifact"},
+		{ClusterName: "Default", Kind: "HelmRepository", Namespace: "flux-system", Name: "podinfo", Ready: "False", Suspended: true},
+	}
+
+	t.Run("table with kinds", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		out := &bytes.Buffer{}
+		g.Expect(printObjects(out, outputTable, true, rows)).To(Succeed())
+
+		g.Expect(out.String()).To(Equal(
+			"CLUSTER   NAMESPACE     KIND             NAME          TENANT   READY   SUSPENDED   MESSAGE\n" +
+				"Default   flux-system   GitRepository    flux-system            True    false       stored artifact\n" +
+				"Default   flux-system   HelmRepository   podinfo                False   true        \n"))
+	})
+
+	t.Run("table without kinds", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		out := &bytes.Buffer{}
+		g.Expect(printObjects(out, outputTable, false, rows[:1])).To(Succeed())
+
+		g.Expect(out.String()).To(Equal(
+			"CLUSTER   NAMESPACE     NAME          TENANT   READY   SUSPENDED   MESSAGE\n" +
+				"Default   flux-system   flux-system            True    false       stored artifact\n"))
+	})
+
+	t.Run("yaml", func(t *testing.T) {
+		g := NewGomegaWithT(t)
+
+		out := &bytes.Buffer{}
+		g.Expect(printObjects(out, outputYAML, false, rows[1:])).To(Succeed())
+
+		g.Expect(out.String()).To(ContainSubstring("- clusterName: Default\n"))
+		g.Expect(out.String()).To(ContainSubstring("  suspended: true\n"))
+	})
+}
+
+func TestPrintEvents(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	out := &bytes.Buffer{}
+	g.Expect(printEvents(out, outputJSON, []*pb.Event{{Type: "Normal", Reason: "ReconciliationSucceeded", Message: "applied"}})).To(Succeed())
+
+	g.Expect(out.String()).To(ContainSubstring(`"reason": "ReconciliationSucceeded"`))
+	g.Expect(out.String()).To(ContainSubstring(`"message": "applied"`))
+}
@@ -0,0 +1,141 @@
+package objects
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"text/tabwriter"
+
+	"github.com/fluxcd/pkg/apis/meta"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// objectRow is what gets printed for each object. The JSON and YAML output
+// include the whole object as the server returned it.
+type objectRow struct {
+	ClusterName string                 `json:"clusterName"`
+	Tenant      string                 `json:"tenant,omitempty"`
+	Kind        string                 `json:"kind"`
+	Namespace   string                 `json:"namespace"`
+	Name        string                 `json:"name"`
+	Suspended   bool                   `json:"suspended"`
+	Ready       string                 `json:"ready"`
+	Message     string                 `json:"message,omitempty"`
+	Object      map[string]interface{} `json:"object"`
+}
+
+func validateOutput(output string) error {
+	switch output {
+	case outputTable, outputJSON, outputYAML:
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q, must be one of: table, json, yaml", output)
+	}
+}
+
+func newObjectRow(kind string, obj *pb.Object) (objectRow, error) {
+	u := unstructured.Unstructured{}
+
+	if err := json.Unmarshal([]byte(obj.Payload), &u.Object); err != nil {
+		return objectRow{}, fmt.Errorf("decoding %s from cluster %s: %w", kind, obj.ClusterName, err)
+	}
+
+	suspended, _, _ := unstructured.NestedBool(u.Object, "spec", "suspend")
+
+	row := objectRow{
+		ClusterName: obj.ClusterName,
+		Tenant:      obj.Tenant,
+		Kind:        kind,
+		Namespace:   u.GetNamespace(),
+		Name:        u.GetName(),
+		Suspended:   suspended,
+		Ready:       "Unknown",
+		Object:      u.Object,
+	}
+
+	conditions, _, _ := unstructured.NestedSlice(u.Object, "status", "conditions")
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != meta.ReadyCondition {
+			continue
+		}
+
+		row.Ready, _ = condition["status"].(string)
+		row.Message, _ = condition["message"].(string)
+	}
+
+	return row, nil
+}
+
+func printObjects(w io.Writer, output string, showKind bool, rows []objectRow) error {
+	switch output {
+	case outputJSON:
+		return printJSON(w, rows)
+	case outputYAML:
+		return printYAML(w, rows)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	if showKind {
+		fmt.Fprintln(tw, "CLUSTER\tNAMESPACE\tKIND\tNAME\tTENANT\tREADY\tSUSPENDED\tMESSAGE")
+	} else {
+		fmt.Fprintln(tw, "CLUSTER\tNAMESPACE\tNAME\tTENANT\tREADY\tSUSPENDED\tMESSAGE")
+	}
+
+	for _, r := range rows {
+		if showKind {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%t\t%s\n", r.ClusterName, r.Namespace, r.Kind, r.Name, r.Tenant, r.Ready, r.Suspended, r.Message)
+		} else {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%t\t%s\n", r.ClusterName, r.Namespace, r.Name, r.Tenant, r.Ready, r.Suspended, r.Message)
+		}
+	}
+
+	return tw.Flush()
+}
+
+func printEvents(w io.Writer, output string, events []*pb.Event) error {
+	switch output {
+	case outputJSON:
+		return printJSON(w, events)
+	case outputYAML:
+		return printYAML(w, events)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(tw, "TIMESTAMP\tTYPE\tREASON\tCOMPONENT\tMESSAGE")
+
+	for _, e := range events {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", e.Timestamp, e.Type, e.Reason, e.Component, e.Message)
+	}
+
+	return tw.Flush()
+}
+
+func printJSON(w io.Writer, v interface{}) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(v)
+}
+
+func printYAML(w io.Writer, v interface{}) error {
+	data, err := yaml.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+
+	return err
+}
@@ -0,0 +1,133 @@
+package run
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/internal/kubeclient"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+	"github.com/weaveworks/weave-gitops/pkg/run/session"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+)
+
+type RunSessionsCommandFlags struct {
+	Output string
+
+	kubeclient.Flags
+}
+
+var flags RunSessionsCommandFlags
+
+var kubeConfigArgs *genericclioptions.ConfigFlags
+
+func RunSessionsCommand(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "run-sessions",
+		Aliases: []string{"run-session"},
+		Short:   "List GitOps Run sessions",
+		Long:    "List GitOps Run sessions, in every namespace unless --namespace is set",
+		Example: `
+# List the GitOps Run sessions in every namespace
+gitops get run-sessions
+
+# List the GitOps Run sessions in the dev namespace as JSON
+gitops get run-sessions -n dev -o json
+`,
+		PreRunE: getRunSessionsPreRunE(opts),
+		RunE:    getRunSessionsRunE(opts),
+
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		DisableAutoGenTag: true,
+	}
+
+	cmd.Flags().StringVarP(&flags.Output, "output", "o", outputTable, "Output format, one of: table, json")
+
+	kubeConfigArgs = run.GetKubeConfigArgs()
+
+	kubeConfigArgs.AddFlags(cmd.Flags())
+
+	return cmd
+}
+
+func getKubeClient(cmd *cobra.Command, args []string) (*kube.KubeHTTP, *rest.Config, error) {
+	log := logger.NewCLILogger(os.Stderr)
+
+	return kubeclient.GetKubeClient(log, cmd, kubeConfigArgs, &flags.Flags)
+}
+
+func getRunSessionsPreRunE(opts *config.Options) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) > 0 {
+			return cmderrors.ErrInvalidArgs
+		}
+
+		switch flags.Output {
+		case outputTable, outputJSON:
+			return nil
+		default:
+			return fmt.Errorf("unsupported output format %q, must be one of: table, json", flags.Output)
+		}
+	}
+}
+
+func getRunSessionsRunE(opts *config.Options) func(cmd *cobra.Command, args []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		kubeClient, _, err := getKubeClient(cmd, args)
+		if err != nil {
+			return err
+		}
+
+		// sessions are made in the default namespace unless asked otherwise,
+		// so the flux-system default of --namespace would hide them.
+		namespace := ""
+		if cmd.Flags().Changed("namespace") {
+			namespace = flags.Namespace
+		}
+
+		internalSessions, err := session.List(kubeClient, namespace)
+		if err != nil {
+			return fmt.Errorf("listing GitOps Run sessions: %w", err)
+		}
+
+		return printSessions(os.Stdout, flags.Output, internalSessions)
+	}
+}
+
+func printSessions(w io.Writer, output string, sessions []*session.InternalSession) error {
+	if output == outputJSON {
+		if sessions == nil {
+			sessions = []*session.InternalSession{}
+		}
+
+		enc := json.NewEncoder(w)
+		enc.SetIndent("", "  ")
+
+		return enc.Encode(sessions)
+	}
+
+	tw := tabwriter.NewWriter(w, 0, 0, 3, ' ', 0)
+
+	fmt.Fprintln(tw, "NAMESPACE\tNAME\tCLI VERSION\tAUTOMATION\tFLUX NAMESPACE\tPORT FORWARDS\tCOMMAND")
+
+	for _, s := range sessions {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%s\t%s\n", s.SessionNamespace, s.SessionName, s.CliVersion, s.AutomationKind, s.FluxNamespace, strings.Join(s.PortForward, ","), s.Command)
+	}
+
+	return tw.Flush()
+}
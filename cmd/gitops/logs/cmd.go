@@ -3,6 +3,7 @@ package logs
 import (
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/logs/run"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/logs/terraform"
 )
 
@@ -13,6 +14,7 @@ func GetCommand(opts *config.Options) *cobra.Command {
 	}
 
 	cmd.AddCommand(terraform.Command(opts))
+	cmd.AddCommand(run.Command(opts))
 
 	return cmd
 }
@@ -0,0 +1,132 @@
+package run
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/coreclient"
+)
+
+// pollInterval is how often new logs are asked for with --follow.
+const pollInterval = 2 * time.Second
+
+type logsRunFlags struct {
+	SessionNamespace string
+	Follow           bool
+}
+
+var flags logsRunFlags
+
+// sessionLogsGetter is the part of the core client the command uses.
+type sessionLogsGetter interface {
+	GetSessionLogs(ctx context.Context, msg *pb.GetSessionLogsRequest) (*pb.GetSessionLogsResponse, error)
+}
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "run <session>",
+		Short: "Get the logs of a GitOps Run session through the Weave GitOps server",
+		Example: `
+# Get the logs of the GitOps Run session "run-main-1234"
+gitops logs run run-main-1234 --endpoint https://gitops.example.com --username admin --password <password>
+
+# Follow the logs of a GitOps Run session in the dev namespace
+gitops logs run run-main-1234 --session-namespace dev -f`,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		PreRunE:           logsRunPreRunE(&opts.Endpoint),
+		RunE:              logsRunRunE(opts),
+		DisableAutoGenTag: true,
+	}
+
+	cmd.Flags().StringVar(&flags.SessionNamespace, "session-namespace", "default", "The namespace of the session")
+	cmd.Flags().BoolVarP(&flags.Follow, "follow", "f", false, "Keep printing new logs until interrupted")
+
+	return cmd
+}
+
+func logsRunPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if *endpoint == "" {
+			return cmderrors.ErrNoEndpoint
+		}
+
+		if len(args) == 0 {
+			return cmderrors.ErrSessionNameIsRequired
+		}
+
+		if len(args) > 1 {
+			return cmderrors.ErrMultipleNames
+		}
+
+		return nil
+	}
+}
+
+func logsRunRunE(opts *config.Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		client, err := coreclient.New(coreclient.Options{
+			Endpoint:              opts.Endpoint,
+			Username:              opts.Username,
+			Password:              opts.Password,
+			InsecureSkipTLSVerify: opts.InsecureSkipTLSVerify,
+		})
+		if err != nil {
+			return err
+		}
+
+		ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+		defer cancel()
+
+		return tailLogs(ctx, client, os.Stdout, &pb.GetSessionLogsRequest{
+			SessionNamespace: flags.SessionNamespace,
+			SessionId:        args[0],
+		}, flags.Follow, pollInterval)
+	}
+}
+
+// tailLogs prints the logs of the session, and with follow, asks for the
+// logs written since the last ones every interval until the context is done.
+func tailLogs(ctx context.Context, client sessionLogsGetter, w io.Writer, req *pb.GetSessionLogsRequest, follow bool, interval time.Duration) error {
+	for {
+		res, err := client.GetSessionLogs(ctx, req)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+
+			return fmt.Errorf("getting logs of session %s/%s: %w", req.SessionNamespace, req.SessionId, err)
+		}
+
+		if res.Error != "" {
+			return fmt.Errorf("getting logs of session %s/%s: %s", req.SessionNamespace, req.SessionId, res.Error)
+		}
+
+		for _, entry := range res.Logs {
+			fmt.Fprintf(w, "%s [%s] %s\n", entry.Timestamp, entry.Level, entry.Message)
+		}
+
+		if res.NextToken != "" {
+			req.Token = res.NextToken
+		}
+
+		if !follow {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(interval):
+		}
+	}
+}
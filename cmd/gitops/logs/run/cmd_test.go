@@ -0,0 +1,68 @@
+package run
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+)
+
+// pagedLogs returns one page of logs per call, and then cancels the context.
+type pagedLogs struct {
+	pages  []*pb.GetSessionLogsResponse
+	tokens []string
+	cancel context.CancelFunc
+}
+
+func (p *pagedLogs) GetSessionLogs(ctx context.Context, msg *pb.GetSessionLogsRequest) (*pb.GetSessionLogsResponse, error) {
+	p.tokens = append(p.tokens, msg.Token)
+
+	if len(p.tokens) > len(p.pages) {
+		p.cancel()
+		return nil, errors.New("canceled")
+	}
+
+	return p.pages[len(p.tokens)-1], nil
+}
+
+func TestTailLogsFollowsFromTheLastToken(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	client := &pagedLogs{
+		pages: []*pb.GetSessionLogsResponse{
+			{Logs: []*pb.LogEntry{{Timestamp: "t1", Level: "info", Message: "first"}}, NextToken: "run/1"},
+			{},
+			{Logs: []*pb.LogEntry{{Timestamp: "t2", Level: "error", Message: "second"}}, NextToken: "run/2"},
+		},
+		cancel: cancel,
+	}
+
+	out := &bytes.Buffer{}
+
+	err := tailLogs(ctx, client, out, &pb.GetSessionLogsRequest{SessionId: "run"}, true, time.Millisecond)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(out.String()).To(Equal("t1 [info] first\nt2 [error] second\n"))
+	g.Expect(client.tokens).To(Equal([]string{"", "run/1", "run/1", "run/2"}))
+}
+
+func TestTailLogsWithoutFollowGetsLogsOnce(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := &pagedLogs{
+		pages: []*pb.GetSessionLogsResponse{
+			{Error: "bucket not found"},
+		},
+	}
+
+	err := tailLogs(context.Background(), client, &bytes.Buffer{}, &pb.GetSessionLogsRequest{SessionNamespace: "default", SessionId: "run"}, false, time.Millisecond)
+	g.Expect(err).To(MatchError("getting logs of session default/run: bucket not found"))
+	g.Expect(client.tokens).To(HaveLen(1))
+}
@@ -5,6 +5,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/cmd/internal/kubeclient"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"github.com/weaveworks/weave-gitops/pkg/run"
@@ -12,17 +13,16 @@ import (
 	"k8s.io/cli-runtime/pkg/genericclioptions"
 	"k8s.io/client-go/rest"
 	"os"
+	"time"
 )
 
 type RunCommandFlags struct {
-	AllSessions bool
+	AllSessions      bool
+	Expired          bool
+	TTL              time.Duration
+	WithoutHeartbeat bool
 
-	// Global flags.
-	Namespace  string
-	KubeConfig string
-
-	// Flags, created by genericclioptions.
-	Context string
+	kubeclient.Flags
 }
 
 var flags RunCommandFlags
@@ -43,6 +43,9 @@ gitops remove run --all-sessions
 
 # Remove all GitOps Run sessions from the dev namespace
 gitops remove run -n dev --all-sessions
+
+# Remove the GitOps Run sessions in the default namespace that haven't been connected to for a day
+gitops remove run -n default --expired --ttl 24h
 `,
 		PreRunE: removeRunPreRunE(opts),
 		RunE:    removeRunRunE(opts),
@@ -55,6 +58,9 @@ gitops remove run -n dev --all-sessions
 	cmdFlags := cmd.Flags()
 
 	cmdFlags.BoolVar(&flags.AllSessions, "all-sessions", false, "Remove all GitOps Run sessions")
+	cmdFlags.BoolVar(&flags.Expired, "expired", false, "Remove the GitOps Run sessions whose CLI hasn't been seen for longer than --ttl, for example because it crashed")
+	cmdFlags.DurationVar(&flags.TTL, "ttl", session.DefaultTTL, "How long a session is kept after its CLI was last seen, used with --expired")
+	cmdFlags.BoolVar(&flags.WithoutHeartbeat, "without-heartbeat", false, "Also remove the sessions started by older CLIs, which don't record when they were last seen, once they're older than --ttl, used with --expired")
 
 	kubeConfigArgs = run.GetKubeConfigArgs()
 
@@ -64,66 +70,24 @@ gitops remove run -n dev --all-sessions
 }
 
 func getKubeClient(cmd *cobra.Command, args []string) (*kube.KubeHTTP, *rest.Config, error) {
-	var err error
-
 	log := logger.NewCLILogger(os.Stdout)
 
-	if flags.Namespace, err = cmd.Flags().GetString("namespace"); err != nil {
-		return nil, nil, err
-	}
-
-	kubeConfigArgs.Namespace = &flags.Namespace
-
-	if flags.KubeConfig, err = cmd.Flags().GetString("kubeconfig"); err != nil {
-		return nil, nil, err
-	}
-
-	if flags.Context, err = cmd.Flags().GetString("context"); err != nil {
-		return nil, nil, err
-	}
-
-	if flags.KubeConfig != "" {
-		kubeConfigArgs.KubeConfig = &flags.KubeConfig
-
-		if flags.Context == "" {
-			log.Failuref("A context should be provided if a kubeconfig is provided")
-			return nil, nil, cmderrors.ErrNoContextForKubeConfig
-		}
-	}
-
-	var contextName string
-
-	if flags.Context != "" {
-		contextName = flags.Context
-	} else {
-		_, contextName, err = kube.RestConfig()
-		if err != nil {
-			log.Failuref("Error getting a restconfig: %v", err.Error())
-			return nil, nil, cmderrors.ErrNoCluster
-		}
-	}
-
-	cfg, err := kubeConfigArgs.ToRESTConfig()
-	if err != nil {
-		return nil, nil, fmt.Errorf("error getting a restconfig from kube config args: %w", err)
-	}
-
-	kubeClientOpts := run.GetKubeClientOptions()
-	kubeClientOpts.BindFlags(cmd.Flags())
-
-	kubeClient, err := run.GetKubeClient(log, contextName, cfg, kubeClientOpts)
-	if err != nil {
-		return nil, nil, cmderrors.ErrGetKubeClient
-	}
-
-	return kubeClient, cfg, nil
+	return kubeclient.GetKubeClient(log, cmd, kubeConfigArgs, &flags.Flags)
 }
 
 func removeRunPreRunE(opts *config.Options) func(cmd *cobra.Command, args []string) error {
 	return func(cmd *cobra.Command, args []string) error {
 		numArgs := len(args)
 
-		if numArgs == 0 && !flags.AllSessions {
+		if flags.AllSessions && flags.Expired {
+			return fmt.Errorf("--all-sessions and --expired cannot be used together")
+		}
+
+		if flags.WithoutHeartbeat && !flags.Expired {
+			return fmt.Errorf("--without-heartbeat can only be used with --expired")
+		}
+
+		if numArgs == 0 && !flags.AllSessions && !flags.Expired {
 			return cmderrors.ErrSessionNameIsRequired
 		}
 
@@ -140,8 +104,18 @@ func removeRunRunE(opts *config.Options) func(cmd *cobra.Command, args []string)
 
 		log := logger.NewCLILogger(os.Stdout)
 
-		if flags.AllSessions {
-			internalSessions, listErr := session.List(kubeClient, flags.Namespace)
+		if flags.AllSessions || flags.Expired {
+			var (
+				internalSessions []*session.InternalSession
+				listErr          error
+			)
+
+			if flags.Expired {
+				internalSessions, listErr = session.ListExpired(kubeClient, flags.Namespace, flags.TTL, time.Now(), flags.WithoutHeartbeat)
+			} else {
+				internalSessions, listErr = session.List(kubeClient, flags.Namespace)
+			}
+
 			if listErr != nil {
 				return listErr
 			}
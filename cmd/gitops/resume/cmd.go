@@ -1,22 +1,93 @@
 package resume
 
 import (
+	"context"
+	"fmt"
+	"os"
+
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/resume/terraform"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/sync"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
 )
 
+type resumeFlags struct {
+	Selector string
+	Cluster  string
+}
+
+var flags resumeFlags
+
 func Command(opts *config.Options) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "resume",
+		Use:   "resume <kind>/<name>...",
 		Short: "Resume a resource",
 		Example: `
-# Suspend a Terraform object from the "flux-system" namespace
+# Resume a Kustomization in the "flux-system" namespace through the Weave GitOps server,
+# and wait for it to sync
+gitops resume kustomization/apps --endpoint https://gitops.example.com --username admin --password <password>
+
+# Resume every HelmRelease labelled team=a in the "apps" namespace
+gitops resume helmreleases -l team=a --namespace apps
+
+# Resume a Terraform object from the "flux-system" namespace
 gitops resume terraform --namespace flux-system my-resource
 `,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		PreRunE:           sync.ParentCommandPreRunE(&opts.Endpoint),
+		RunE:              resumeCommandRunE(opts),
+		DisableAutoGenTag: true,
 	}
 
+	sync.AddObjectFlags(cmd, &flags.Selector, &flags.Cluster)
+
 	cmd.AddCommand(terraform.Command(opts))
 
 	return cmd
 }
+
+func resumeCommandRunE(opts *config.Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return cmd.Help()
+		}
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		client, err := sync.NewClient(opts)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		refs, err := client.ObjectRefs(ctx, args, flags.Selector, namespace, flags.Cluster)
+		if err != nil {
+			return err
+		}
+
+		log := logger.NewCLILogger(os.Stdout)
+
+		if len(refs) == 0 {
+			log.Warningf("No objects matched")
+			return nil
+		}
+
+		if _, err := client.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{
+			Objects: refs,
+			Suspend: false,
+		}); err != nil {
+			return fmt.Errorf("resuming: %w", err)
+		}
+
+		// Like flux resume, wait for the objects to catch up with whatever
+		// changed while they were suspended.
+		return sync.SyncObjects(ctx, client, log, refs, false)
+	}
+}
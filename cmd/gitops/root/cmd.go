@@ -11,6 +11,7 @@ import (
 	"github.com/weaveworks/weave-gitops/cmd/gitops/replan"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/resume"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/suspend"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/sync"
 
 	"github.com/weaveworks/weave-gitops/cmd/gitops/remove"
 
@@ -158,7 +159,7 @@ func RootCmd() *cobra.Command {
 	rootCmd.AddCommand(get.GetCommand(options))
 	rootCmd.AddCommand(set.SetCommand(options))
 	rootCmd.AddCommand(docs.Cmd)
-	rootCmd.AddCommand(check.Cmd)
+	rootCmd.AddCommand(check.Command(options))
 	rootCmd.AddCommand(beta.GetCommand(options))
 	rootCmd.AddCommand(create.GetCommand(options))
 	rootCmd.AddCommand(deletepkg.GetCommand(options))
@@ -168,6 +169,7 @@ func RootCmd() *cobra.Command {
 	rootCmd.AddCommand(resume.Command(options))
 	rootCmd.AddCommand(run.RunCommand(options))
 	rootCmd.AddCommand(suspend.Command(options))
+	rootCmd.AddCommand(sync.Command(options))
 
 	return rootCmd
 }
@@ -1,22 +1,95 @@
 package suspend
 
 import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+
 	"github.com/spf13/cobra"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
 	"github.com/weaveworks/weave-gitops/cmd/gitops/suspend/terraform"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/sync"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
 )
 
+type suspendFlags struct {
+	Selector string
+	Cluster  string
+}
+
+var flags suspendFlags
+
 func Command(opts *config.Options) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:   "suspend",
+		Use:   "suspend <kind>/<name>...",
 		Short: "Suspend a resource",
 		Example: `
+# Suspend a Kustomization in the "flux-system" namespace through the Weave GitOps server
+gitops suspend kustomization/apps --endpoint https://gitops.example.com --username admin --password <password>
+
+# Suspend every HelmRelease labelled team=a in the "apps" namespace
+gitops suspend helmreleases -l team=a --namespace apps
+
 # Suspend a Terraform object in the "flux-system" namespace
-gitops resume terraform --namespace flux-system my-resource
+gitops suspend terraform --namespace flux-system my-resource
 `,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		PreRunE:           sync.ParentCommandPreRunE(&opts.Endpoint),
+		RunE:              suspendCommandRunE(opts),
+		DisableAutoGenTag: true,
 	}
 
+	sync.AddObjectFlags(cmd, &flags.Selector, &flags.Cluster)
+
 	cmd.AddCommand(terraform.Command(opts))
 
 	return cmd
 }
+
+func suspendCommandRunE(opts *config.Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return cmd.Help()
+		}
+
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		client, err := sync.NewClient(opts)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		refs, err := client.ObjectRefs(ctx, args, flags.Selector, namespace, flags.Cluster)
+		if err != nil {
+			return err
+		}
+
+		log := logger.NewCLILogger(os.Stdout)
+
+		if len(refs) == 0 {
+			log.Warningf("No objects matched")
+			return nil
+		}
+
+		if _, err := client.ToggleSuspendResource(ctx, &pb.ToggleSuspendResourceRequest{
+			Objects: refs,
+			Suspend: true,
+		}); err != nil {
+			return fmt.Errorf("suspending: %w", err)
+		}
+
+		for _, ref := range refs {
+			log.Successf("Suspended %s/%s in namespace %s of cluster %s", strings.ToLower(ref.Kind), ref.Name, ref.Namespace, ref.ClusterName)
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,170 @@
+package sync
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/config"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/coreclient"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+)
+
+type syncFlags struct {
+	WithSource bool
+	Selector   string
+	Cluster    string
+}
+
+var flags syncFlags
+
+func Command(opts *config.Options) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sync <kind>/<name>...",
+		Short: "Trigger a reconciliation of Flux objects through the Weave GitOps server and wait for it to complete",
+		Example: `
+# Sync a Kustomization in the "flux-system" namespace
+gitops sync kustomization/apps --endpoint https://gitops.example.com --username admin --password <password>
+
+# Sync a HelmRelease together with its HelmRepository
+gitops sync helmrelease/podinfo --namespace apps --with-source
+
+# Sync every Kustomization labelled team=a on a leaf cluster
+gitops sync kustomizations -l team=a --cluster dev/leaf`,
+		SilenceUsage:      true,
+		SilenceErrors:     true,
+		PreRunE:           ObjectsCommandPreRunE(&opts.Endpoint),
+		RunE:              syncCommandRunE(opts),
+		DisableAutoGenTag: true,
+	}
+
+	cmd.Flags().BoolVar(&flags.WithSource, "with-source", false, "Also sync the source of the objects first")
+	AddObjectFlags(cmd, &flags.Selector, &flags.Cluster)
+
+	return cmd
+}
+
+// AddObjectFlags adds the flags used to pick the objects to act on.
+func AddObjectFlags(cmd *cobra.Command, selector, clusterName *string) {
+	cmd.Flags().StringVarP(selector, "selector", "l", "", "Act on every object of the given kind that has these labels, e.g. -l team=a,env=dev")
+	cmd.Flags().StringVar(clusterName, "cluster", cluster.DefaultCluster, "The cluster the objects are in")
+}
+
+// ObjectsCommandPreRunE checks that the server endpoint and at least one
+// object have been given.
+func ObjectsCommandPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if *endpoint == "" {
+			return cmderrors.ErrNoEndpoint
+		}
+
+		if len(args) == 0 {
+			return fmt.Errorf("at least one <kind>/<name> argument is required")
+		}
+
+		return nil
+	}
+}
+
+// ParentCommandPreRunE is ObjectsCommandPreRunE for commands that also have
+// subcommands, e.g. gitops suspend. Without objects they print their help,
+// as they did before they could act on objects themselves, so the endpoint
+// is only needed when objects are given.
+func ParentCommandPreRunE(endpoint *string) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		if len(args) == 0 {
+			return nil
+		}
+
+		return ObjectsCommandPreRunE(endpoint)(cmd, args)
+	}
+}
+
+func syncCommandRunE(opts *config.Options) func(*cobra.Command, []string) error {
+	return func(cmd *cobra.Command, args []string) error {
+		namespace, err := cmd.Flags().GetString("namespace")
+		if err != nil {
+			return err
+		}
+
+		client, err := NewClient(opts)
+		if err != nil {
+			return err
+		}
+
+		ctx := context.Background()
+
+		refs, err := client.ObjectRefs(ctx, args, flags.Selector, namespace, flags.Cluster)
+		if err != nil {
+			return err
+		}
+
+		return SyncObjects(ctx, client, logger.NewCLILogger(os.Stdout), refs, flags.WithSource)
+	}
+}
+
+// SyncObjects syncs the objects in parallel, reporting each one as it starts
+// and as the server reports it has finished reconciling.
+func SyncObjects(ctx context.Context, client *coreclient.Client, log logger.Logger, refs []*pb.ObjectRef, withSource bool) error {
+	if len(refs) == 0 {
+		log.Warningf("No objects matched")
+		return nil
+	}
+
+	var (
+		errs   = &multierror.Error{}
+		errsMu = sync.Mutex{}
+		wg     = sync.WaitGroup{}
+	)
+
+	for _, ref := range refs {
+		wg.Add(1)
+
+		go func(ref *pb.ObjectRef) {
+			defer wg.Done()
+
+			log.Waitingf("Waiting for %s to sync", describe(ref))
+
+			_, err := client.SyncFluxObject(ctx, &pb.SyncFluxObjectRequest{
+				Objects:    []*pb.ObjectRef{ref},
+				WithSource: withSource,
+			})
+			if err != nil {
+				log.Failuref("Syncing %s failed: %v", describe(ref), err)
+
+				errsMu.Lock()
+				errs = multierror.Append(errs, fmt.Errorf("syncing %s: %w", describe(ref), err))
+				errsMu.Unlock()
+
+				return
+			}
+
+			log.Successf("Synced %s", describe(ref))
+		}(ref)
+	}
+
+	wg.Wait()
+
+	return errs.ErrorOrNil()
+}
+
+// NewClient returns a client for the server the root flags point at.
+func NewClient(opts *config.Options) (*coreclient.Client, error) {
+	return coreclient.New(coreclient.Options{
+		Endpoint:              opts.Endpoint,
+		Username:              opts.Username,
+		Password:              opts.Password,
+		InsecureSkipTLSVerify: opts.InsecureSkipTLSVerify,
+	})
+}
+
+func describe(ref *pb.ObjectRef) string {
+	return fmt.Sprintf("%s/%s in namespace %s of cluster %s", strings.ToLower(ref.Kind), ref.Name, ref.Namespace, ref.ClusterName)
+}
@@ -0,0 +1,81 @@
+package kubeclient
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/weaveworks/weave-gitops/cmd/gitops/cmderrors"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/run"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/rest"
+)
+
+// Flags are the flags that pick the cluster and namespace the GitOps Run
+// commands work with.
+type Flags struct {
+	// Global flags.
+	Namespace  string
+	KubeConfig string
+
+	// Flags, created by genericclioptions.
+	Context string
+}
+
+// GetKubeClient reads the namespace, kubeconfig and context flags of cmd
+// into flags, and returns a client for the cluster they pick along with its
+// rest config. kubeConfigArgs must have been bound to the flags of cmd.
+func GetKubeClient(log logger.Logger, cmd *cobra.Command, kubeConfigArgs *genericclioptions.ConfigFlags, flags *Flags) (*kube.KubeHTTP, *rest.Config, error) {
+	var err error
+
+	if flags.Namespace, err = cmd.Flags().GetString("namespace"); err != nil {
+		return nil, nil, err
+	}
+
+	kubeConfigArgs.Namespace = &flags.Namespace
+
+	if flags.KubeConfig, err = cmd.Flags().GetString("kubeconfig"); err != nil {
+		return nil, nil, err
+	}
+
+	if flags.Context, err = cmd.Flags().GetString("context"); err != nil {
+		return nil, nil, err
+	}
+
+	if flags.KubeConfig != "" {
+		kubeConfigArgs.KubeConfig = &flags.KubeConfig
+
+		if flags.Context == "" {
+			log.Failuref("A context should be provided if a kubeconfig is provided")
+			return nil, nil, cmderrors.ErrNoContextForKubeConfig
+		}
+	}
+
+	var contextName string
+
+	if flags.Context != "" {
+		contextName = flags.Context
+	} else {
+		_, contextName, err = kube.RestConfig()
+		if err != nil {
+			log.Failuref("Error getting a restconfig: %v", err.Error())
+			return nil, nil, cmderrors.ErrNoCluster
+		}
+	}
+
+	cfg, err := kubeConfigArgs.ToRESTConfig()
+	if err != nil {
+		return nil, nil, fmt.Errorf("error getting a restconfig from kube config args: %w", err)
+	}
+
+	kubeClientOpts := run.GetKubeClientOptions()
+	kubeClientOpts.BindFlags(cmd.Flags())
+
+	kubeClient, err := run.GetKubeClient(log, contextName, cfg, kubeClientOpts)
+	if err != nil {
+		return nil, nil, cmderrors.ErrGetKubeClient
+	}
+
+	return kubeClient, cfg, nil
+}
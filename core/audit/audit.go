@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+//go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
+
+// Action is what the user attempted to do.
+type Action string
+
+const (
+	ActionSync     Action = "sync"
+	ActionSuspend  Action = "suspend"
+	ActionResume   Action = "resume"
+	ActionRollback Action = "rollback"
+	ActionSignIn   Action = "sign-in"
+	ActionCallback Action = "oidc-callback"
+	ActionLogout   Action = "logout"
+)
+
+// Outcome is whether the action succeeded.
+type Outcome string
+
+const (
+	OutcomeSuccess Outcome = "success"
+	OutcomeFailure Outcome = "failure"
+)
+
+// ObjectRef identifies the object an action was performed on.
+type ObjectRef struct {
+	APIVersion string `json:"apiVersion,omitempty"`
+	Kind       string `json:"kind"`
+	Name       string `json:"name"`
+	Namespace  string `json:"namespace,omitempty"`
+}
+
+// Event is a single entry in the audit trail.
+type Event struct {
+	Timestamp time.Time  `json:"timestamp"`
+	Principal string     `json:"principal,omitempty"`
+	Groups    []string   `json:"groups,omitempty"`
+	Action    Action     `json:"action"`
+	Cluster   string     `json:"cluster,omitempty"`
+	Object    *ObjectRef `json:"object,omitempty"`
+	Outcome   Outcome    `json:"outcome"`
+	Error     string     `json:"error,omitempty"`
+}
+
+// NewEvent returns an Event for the given action, with the outcome derived
+// from err.
+func NewEvent(action Action, principal string, groups []string, err error) Event {
+	ev := Event{
+		Timestamp: time.Now().UTC(),
+		Principal: principal,
+		Groups:    groups,
+		Action:    action,
+		Outcome:   OutcomeSuccess,
+	}
+
+	if err != nil {
+		ev.Outcome = OutcomeFailure
+		ev.Error = err.Error()
+	}
+
+	return ev
+}
+
+// AuditSink records audit events somewhere durable.
+//
+//counterfeiter:generate . AuditSink
+type AuditSink interface {
+	Record(ctx context.Context, event Event) error
+}
+
+// NoopSink discards all events, it's used when auditing isn't configured.
+type NoopSink struct{}
+
+func (NoopSink) Record(ctx context.Context, event Event) error {
+	return nil
+}
+
+type multiSink []AuditSink
+
+// NewMultiSink returns an AuditSink that records every event to all the given sinks.
+func NewMultiSink(sinks ...AuditSink) AuditSink {
+	return multiSink(sinks)
+}
+
+func (sinks multiSink) Record(ctx context.Context, event Event) error {
+	var result *multierror.Error
+
+	for _, sink := range sinks {
+		if err := sink.Record(ctx, event); err != nil {
+			result = multierror.Append(result, err)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
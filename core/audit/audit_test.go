@@ -0,0 +1,135 @@
+package audit_test
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/audit/auditfakes"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestNewEvent(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ev := audit.NewEvent(audit.ActionSync, "anne", []string{"devs"}, nil)
+	g.Expect(ev.Outcome).To(Equal(audit.OutcomeSuccess))
+	g.Expect(ev.Error).To(BeEmpty())
+	g.Expect(ev.Timestamp.IsZero()).To(BeFalse())
+
+	ev = audit.NewEvent(audit.ActionSync, "anne", nil, errors.New("nope"))
+	g.Expect(ev.Outcome).To(Equal(audit.OutcomeFailure))
+	g.Expect(ev.Error).To(Equal("nope"))
+}
+
+func TestFileSink(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	path := filepath.Join(t.TempDir(), "audit.log")
+
+	sink, err := audit.NewFileSink(path)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(sink.Record(context.Background(), audit.NewEvent(audit.ActionSignIn, "anne", nil, nil))).To(Succeed())
+	g.Expect(sink.Record(context.Background(), audit.NewEvent(audit.ActionLogout, "anne", nil, nil))).To(Succeed())
+
+	f, err := os.Open(path)
+	g.Expect(err).NotTo(HaveOccurred())
+	defer f.Close()
+
+	actions := []audit.Action{}
+	scanner := bufio.NewScanner(f)
+
+	for scanner.Scan() {
+		ev := audit.Event{}
+		g.Expect(json.Unmarshal(scanner.Bytes(), &ev)).To(Succeed())
+		g.Expect(ev.Principal).To(Equal("anne"))
+
+		actions = append(actions, ev.Action)
+	}
+
+	g.Expect(actions).To(Equal([]audit.Action{audit.ActionSignIn, audit.ActionLogout}))
+}
+
+func TestEventSink(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	sink := audit.NewEventSink(c, "flux-system", "Default")
+
+	ev := audit.NewEvent(audit.ActionSuspend, "anne", []string{"devs", "ops"}, errors.New("forbidden"))
+	ev.Cluster = "Default"
+	ev.Object = &audit.ObjectRef{APIVersion: "kustomize.toolkit.fluxcd.io/v1beta2", Kind: "Kustomization", Name: "podinfo", Namespace: "apps"}
+
+	g.Expect(sink.Record(context.Background(), ev)).To(Succeed())
+	g.Expect(sink.Record(context.Background(), audit.NewEvent(audit.ActionSignIn, "anne", nil, nil))).To(Succeed())
+
+	events := &corev1.EventList{}
+	g.Expect(c.List(context.Background(), events, client.InNamespace("apps"))).To(Succeed())
+	g.Expect(events.Items).To(HaveLen(1))
+
+	recorded := events.Items[0]
+	g.Expect(recorded.Type).To(Equal(corev1.EventTypeWarning))
+	g.Expect(recorded.Reason).To(Equal(string(audit.ActionSuspend)))
+	g.Expect(recorded.InvolvedObject.Name).To(Equal("podinfo"))
+	g.Expect(recorded.InvolvedObject.APIVersion).To(Equal("kustomize.toolkit.fluxcd.io/v1beta2"))
+	g.Expect(recorded.Annotations).To(HaveKeyWithValue("audit.weave.works/principal", "anne"))
+	g.Expect(recorded.Annotations).To(HaveKeyWithValue("audit.weave.works/groups", "devs,ops"))
+	g.Expect(recorded.Message).To(ContainSubstring("forbidden"))
+
+	g.Expect(c.List(context.Background(), events, client.InNamespace("flux-system"))).To(Succeed())
+	g.Expect(events.Items).To(HaveLen(1))
+	g.Expect(events.Items[0].Type).To(Equal(corev1.EventTypeNormal))
+	g.Expect(events.Items[0].InvolvedObject.APIVersion).To(Equal("v1"))
+}
+
+func TestEventSinkRecordsLeafClusterEventsInItsNamespace(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	c := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	sink := audit.NewEventSink(c, "flux-system", "Default")
+
+	ev := audit.NewEvent(audit.ActionSync, "anne", nil, nil)
+	ev.Cluster = "leaf"
+	ev.Object = &audit.ObjectRef{APIVersion: "kustomize.toolkit.fluxcd.io/v1beta2", Kind: "Kustomization", Name: "podinfo", Namespace: "apps"}
+
+	g.Expect(sink.Record(context.Background(), ev)).To(Succeed())
+
+	events := &corev1.EventList{}
+	g.Expect(c.List(context.Background(), events, client.InNamespace("apps"))).To(Succeed())
+	g.Expect(events.Items).To(BeEmpty())
+
+	g.Expect(c.List(context.Background(), events, client.InNamespace("flux-system"))).To(Succeed())
+	g.Expect(events.Items).To(HaveLen(1))
+
+	recorded := events.Items[0]
+	g.Expect(recorded.InvolvedObject.Kind).To(Equal("Namespace"))
+	g.Expect(recorded.InvolvedObject.Namespace).To(Equal("flux-system"))
+	g.Expect(recorded.Annotations).To(HaveKeyWithValue("audit.weave.works/cluster", "leaf"))
+	g.Expect(recorded.Annotations).To(HaveKeyWithValue("audit.weave.works/object", "Kustomization apps/podinfo"))
+	g.Expect(recorded.Message).To(ContainSubstring(`in cluster "leaf"`))
+}
+
+func TestMultiSink(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	first := &auditfakes.FakeAuditSink{}
+	first.RecordReturns(errors.New("disk full"))
+	second := &auditfakes.FakeAuditSink{}
+
+	sink := audit.NewMultiSink(first, second)
+
+	err := sink.Record(context.Background(), audit.NewEvent(audit.ActionSync, "anne", nil, nil))
+	g.Expect(err).To(MatchError(ContainSubstring("disk full")))
+	g.Expect(first.RecordCallCount()).To(Equal(1))
+	g.Expect(second.RecordCallCount()).To(Equal(1))
+}
@@ -0,0 +1,114 @@
+// Code generated by counterfeiter. DO NOT EDIT.
+package auditfakes
+
+import (
+	"context"
+	"sync"
+
+	"github.com/weaveworks/weave-gitops/core/audit"
+)
+
+type FakeAuditSink struct {
+	RecordStub        func(context.Context, audit.Event) error
+	recordMutex       sync.RWMutex
+	recordArgsForCall []struct {
+		arg1 context.Context
+		arg2 audit.Event
+	}
+	recordReturns struct {
+		result1 error
+	}
+	recordReturnsOnCall map[int]struct {
+		result1 error
+	}
+	invocations      map[string][][]interface{}
+	invocationsMutex sync.RWMutex
+}
+
+func (fake *FakeAuditSink) Record(arg1 context.Context, arg2 audit.Event) error {
+	fake.recordMutex.Lock()
+	ret, specificReturn := fake.recordReturnsOnCall[len(fake.recordArgsForCall)]
+	fake.recordArgsForCall = append(fake.recordArgsForCall, struct {
+		arg1 context.Context
+		arg2 audit.Event
+	}{arg1, arg2})
+	stub := fake.RecordStub
+	fakeReturns := fake.recordReturns
+	fake.recordInvocation("Record", []interface{}{arg1, arg2})
+	fake.recordMutex.Unlock()
+	if stub != nil {
+		return stub(arg1, arg2)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeAuditSink) RecordCallCount() int {
+	fake.recordMutex.RLock()
+	defer fake.recordMutex.RUnlock()
+	return len(fake.recordArgsForCall)
+}
+
+func (fake *FakeAuditSink) RecordCalls(stub func(context.Context, audit.Event) error) {
+	fake.recordMutex.Lock()
+	defer fake.recordMutex.Unlock()
+	fake.RecordStub = stub
+}
+
+func (fake *FakeAuditSink) RecordArgsForCall(i int) (context.Context, audit.Event) {
+	fake.recordMutex.RLock()
+	defer fake.recordMutex.RUnlock()
+	argsForCall := fake.recordArgsForCall[i]
+	return argsForCall.arg1, argsForCall.arg2
+}
+
+func (fake *FakeAuditSink) RecordReturns(result1 error) {
+	fake.recordMutex.Lock()
+	defer fake.recordMutex.Unlock()
+	fake.RecordStub = nil
+	fake.recordReturns = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditSink) RecordReturnsOnCall(i int, result1 error) {
+	fake.recordMutex.Lock()
+	defer fake.recordMutex.Unlock()
+	fake.RecordStub = nil
+	if fake.recordReturnsOnCall == nil {
+		fake.recordReturnsOnCall = make(map[int]struct {
+			result1 error
+		})
+	}
+	fake.recordReturnsOnCall[i] = struct {
+		result1 error
+	}{result1}
+}
+
+func (fake *FakeAuditSink) Invocations() map[string][][]interface{} {
+	fake.invocationsMutex.RLock()
+	defer fake.invocationsMutex.RUnlock()
+	fake.recordMutex.RLock()
+	defer fake.recordMutex.RUnlock()
+	copiedInvocations := map[string][][]interface{}{}
+	for key, value := range fake.invocations {
+		copiedInvocations[key] = value
+	}
+	return copiedInvocations
+}
+
+func (fake *FakeAuditSink) recordInvocation(key string, args []interface{}) {
+	fake.invocationsMutex.Lock()
+	defer fake.invocationsMutex.Unlock()
+	if fake.invocations == nil {
+		fake.invocations = map[string][][]interface{}{}
+	}
+	if fake.invocations[key] == nil {
+		fake.invocations[key] = [][]interface{}{}
+	}
+	fake.invocations[key] = append(fake.invocations[key], args)
+}
+
+var _ audit.AuditSink = new(FakeAuditSink)
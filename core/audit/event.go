@@ -0,0 +1,117 @@
+package audit
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// EventReportingController is the source set on the Kubernetes events
+	// recorded by the event sink.
+	EventReportingController = "weave-gitops-server"
+
+	annotationPrefix = "audit.weave.works/"
+)
+
+type eventSink struct {
+	client            client.Client
+	namespace         string
+	managementCluster string
+}
+
+// NewEventSink returns an AuditSink that records events as Kubernetes Events
+// in the management cluster. Events about objects in the management cluster
+// are recorded in the object's namespace. Anything else, e.g. sign ins or
+// objects in leaf clusters, whose namespaces may not exist in the management
+// cluster, is recorded in the given namespace.
+func NewEventSink(c client.Client, namespace, managementCluster string) AuditSink {
+	return &eventSink{client: c, namespace: namespace, managementCluster: managementCluster}
+}
+
+func (s *eventSink) Record(ctx context.Context, event Event) error {
+	namespace := s.namespace
+	involved := corev1.ObjectReference{
+		APIVersion: "v1",
+		Kind:       "Namespace",
+		Name:       s.namespace,
+		Namespace:  s.namespace,
+	}
+
+	annotations := map[string]string{
+		annotationPrefix + "principal": event.Principal,
+		annotationPrefix + "groups":    strings.Join(event.Groups, ","),
+		annotationPrefix + "cluster":   event.Cluster,
+		annotationPrefix + "outcome":   string(event.Outcome),
+	}
+
+	if event.Object != nil {
+		annotations[annotationPrefix+"object"] = fmt.Sprintf("%s %s/%s", event.Object.Kind, event.Object.Namespace, event.Object.Name)
+
+		inManagementCluster := event.Cluster == "" || event.Cluster == s.managementCluster
+
+		if inManagementCluster && event.Object.Namespace != "" {
+			namespace = event.Object.Namespace
+			involved = corev1.ObjectReference{
+				APIVersion: event.Object.APIVersion,
+				Kind:       event.Object.Kind,
+				Name:       event.Object.Name,
+				Namespace:  event.Object.Namespace,
+			}
+		}
+	}
+
+	eventType := corev1.EventTypeNormal
+	if event.Outcome == OutcomeFailure {
+		eventType = corev1.EventTypeWarning
+	}
+
+	timestamp := metav1.NewTime(event.Timestamp)
+
+	k8sEvent := &corev1.Event{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: "weave-gitops-audit-",
+			Namespace:    namespace,
+			Annotations:  annotations,
+		},
+		InvolvedObject:      involved,
+		Reason:              string(event.Action),
+		Message:             eventMessage(event),
+		Type:                eventType,
+		FirstTimestamp:      timestamp,
+		LastTimestamp:       timestamp,
+		Count:               1,
+		Source:              corev1.EventSource{Component: EventReportingController},
+		ReportingController: EventReportingController,
+	}
+
+	if err := s.client.Create(ctx, k8sEvent); err != nil {
+		return fmt.Errorf("failed creating audit event: %w", err)
+	}
+
+	return nil
+}
+
+func eventMessage(event Event) string {
+	msg := fmt.Sprintf("user %q performed %s", event.Principal, event.Action)
+
+	if event.Object != nil {
+		msg += fmt.Sprintf(" on %s %s/%s", event.Object.Kind, event.Object.Namespace, event.Object.Name)
+	}
+
+	if event.Cluster != "" {
+		msg += fmt.Sprintf(" in cluster %q", event.Cluster)
+	}
+
+	msg += ": " + string(event.Outcome)
+
+	if event.Error != "" {
+		msg += ": " + event.Error
+	}
+
+	return msg
+}
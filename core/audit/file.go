@@ -0,0 +1,41 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+)
+
+type fileSink struct {
+	mu   sync.Mutex
+	file *os.File
+}
+
+// NewFileSink returns an AuditSink that appends events as JSON lines to the
+// file at path, creating it if needed.
+func NewFileSink(path string) (AuditSink, error) {
+	file, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("failed opening audit log: %w", err)
+	}
+
+	return &fileSink{file: file}, nil
+}
+
+func (s *fileSink) Record(ctx context.Context, event Event) error {
+	line, err := json.Marshal(event)
+	if err != nil {
+		return fmt.Errorf("failed encoding audit event: %w", err)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, err := s.file.Write(append(line, '\n')); err != nil {
+		return fmt.Errorf("failed writing audit event: %w", err)
+	}
+
+	return nil
+}
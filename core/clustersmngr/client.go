@@ -10,6 +10,9 @@ import (
 	"sync"
 	"time"
 
+	"github.com/weaveworks/weave-gitops/core/tracing"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -100,7 +103,10 @@ func (c *clustersClient) Namespaces() map[string][]v1.Namespace {
 	return c.namespaces
 }
 
-func (c *clustersClient) Get(ctx context.Context, cluster string, key client.ObjectKey, obj client.Object) error {
+func (c *clustersClient) Get(ctx context.Context, cluster string, key client.ObjectKey, obj client.Object) (err error) {
+	ctx, span := startClusterSpan(ctx, "clustersmngr.Get", cluster, key.Namespace)
+	defer func() { tracing.EndSpan(span, err) }()
+
 	client, err := c.pool.Client(cluster)
 	if err != nil {
 		return err
@@ -112,7 +118,10 @@ func (c *clustersClient) Get(ctx context.Context, cluster string, key client.Obj
 	return client.Get(ctx, key, obj)
 }
 
-func (c *clustersClient) List(ctx context.Context, cluster string, list client.ObjectList, opts ...client.ListOption) error {
+func (c *clustersClient) List(ctx context.Context, cluster string, list client.ObjectList, opts ...client.ListOption) (err error) {
+	ctx, span := startClusterSpan(ctx, "clustersmngr.List", cluster, listNamespace(opts...))
+	defer func() { tracing.EndSpan(span, err) }()
+
 	client, err := c.pool.Client(cluster)
 	if err != nil {
 		return err
@@ -128,7 +137,10 @@ func (c *clustersClient) List(ctx context.Context, cluster string, list client.O
 	return client.List(ctx, list, opts...)
 }
 
-func (c *clustersClient) ClusteredList(ctx context.Context, clist ClusteredObjectList, namespaced bool, opts ...client.ListOption) error {
+func (c *clustersClient) ClusteredList(ctx context.Context, clist ClusteredObjectList, namespaced bool, opts ...client.ListOption) (err error) {
+	ctx, span := tracing.Tracer().Start(ctx, "clustersmngr.ClusteredList")
+	defer func() { tracing.EndSpan(span, err) }()
+
 	paginationInfo := &PaginationInfo{}
 
 	continueToken := extractContinueToken(opts...)
@@ -158,33 +170,40 @@ func (c *clustersClient) ClusteredList(ctx context.Context, clist ClusteredObjec
 				continue
 			}
 
-			listOpts := append(opts, client.Continue(nsContinueToken))
+			listOpts := append(opts, paginationInfo.Continue(clusterName, ns.Name))
 			listOpts = append(listOpts, client.InNamespace(ns.Name))
 
 			wg.Add(1)
 
-			go func(clusterName string, nsName string, c client.Client, optsWithNamespace ...client.ListOption) {
+			go func(clusterName string, nsName string, nsContinueToken string, c client.Client, optsWithNamespace ...client.ListOption) {
 				defer wg.Done()
 
+				// One span per cluster and namespace, to show which are slow.
+				ctx, span := startClusterSpan(ctx, "clustersmngr.List", clusterName, nsName)
+
 				list := clist.NewList()
 
 				ctx, cancel := context.WithTimeout(ctx, clientTimeout)
 				defer cancel()
 
-				if err := c.List(ctx, list, optsWithNamespace...); err != nil {
+				err := c.List(ctx, list, optsWithNamespace...)
+				if err != nil {
 					errs.Add(ListError{Cluster: clusterName, Namespace: nsName, Err: err})
+					paginationInfo.SetFailed(clusterName, nsName, nsContinueToken)
+				} else {
+					paginationInfo.Set(clusterName, nsName, list.GetContinue())
 				}
 
-				paginationInfo.Set(clusterName, nsName, list.GetContinue())
+				tracing.EndSpan(span, err)
 
 				clist.AddObjectList(clusterName, list)
-			}(clusterName, ns.Name, cc, listOpts...)
+			}(clusterName, ns.Name, nsContinueToken, cc, listOpts...)
 		}
 	}
 
 	wg.Wait()
 
-	continueToken, err := encodeToBase64(paginationInfo)
+	continueToken, err = encodeToBase64(paginationInfo)
 	if err != nil {
 		return fmt.Errorf("failed encoding pagination info: %w", err)
 	}
@@ -198,6 +217,22 @@ func (c *clustersClient) ClusteredList(ctx context.Context, clist ClusteredObjec
 	return nil
 }
 
+func startClusterSpan(ctx context.Context, name, cluster, namespace string) (context.Context, trace.Span) {
+	ctx, span := tracing.Tracer().Start(ctx, name, trace.WithAttributes(semconv.K8SClusterNameKey.String(cluster)))
+	if namespace != "" {
+		span.SetAttributes(semconv.K8SNamespaceNameKey.String(namespace))
+	}
+
+	return ctx, span
+}
+
+func listNamespace(opts ...client.ListOption) string {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	return listOpts.Namespace
+}
+
 func extractContinueToken(opts ...client.ListOption) string {
 	for _, o := range opts {
 		switch v := o.(type) {
@@ -311,6 +346,10 @@ func (cl *ClusteredList) SetContinue(continueToken string) {
 	cl.continueToken = continueToken
 }
 
+// failedFirstPageToken is recorded as the continue token of a namespace whose
+// first page couldn't be listed.
+const failedFirstPageToken = "failed-first-page"
+
 type PaginationInfo struct {
 	sync.Mutex
 	ContinueTokens map[string]map[string]string
@@ -350,6 +389,65 @@ func (pi *PaginationInfo) Get(cluster string, namespace string) string {
 	return ""
 }
 
+// SetFailed records that listing the namespace from the continue token
+// failed, so that the next page lists it again from the same token.
+func (pi *PaginationInfo) SetFailed(cluster string, namespace string, token string) {
+	if token == "" {
+		// An empty token means the namespace has been fully listed.
+		token = failedFirstPageToken
+	}
+
+	pi.Set(cluster, namespace, token)
+}
+
+// Continue returns the option to list the next page of the namespace with.
+func (pi *PaginationInfo) Continue(cluster string, namespace string) client.Continue {
+	token := pi.Get(cluster, namespace)
+	if token == failedFirstPageToken {
+		token = ""
+	}
+
+	return client.Continue(token)
+}
+
+// HasMore returns true if any cluster/namespace pair still has items to be listed.
+func (pi *PaginationInfo) HasMore() bool {
+	pi.Lock()
+	defer pi.Unlock()
+
+	for _, namespaces := range pi.ContinueTokens {
+		for _, token := range namespaces {
+			if token != "" {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// DecodePaginationInfo decodes a continue token as returned by ClusteredList.
+func DecodePaginationInfo(continueToken string) (*PaginationInfo, error) {
+	paginationInfo := &PaginationInfo{}
+
+	if err := decodeFromBase64(paginationInfo, continueToken); err != nil {
+		return nil, fmt.Errorf("failed decoding pagination info: %w", err)
+	}
+
+	return paginationInfo, nil
+}
+
+// EncodePaginationInfo encodes the pagination info into a continue token
+// that can be passed to ClusteredList or DecodePaginationInfo.
+func EncodePaginationInfo(paginationInfo *PaginationInfo) (string, error) {
+	continueToken, err := encodeToBase64(paginationInfo)
+	if err != nil {
+		return "", fmt.Errorf("failed encoding pagination info: %w", err)
+	}
+
+	return continueToken, nil
+}
+
 func decodeFromBase64(v interface{}, enc string) error {
 	return json.NewDecoder(base64.NewDecoder(base64.StdEncoding, strings.NewReader(enc))).Decode(v)
 }
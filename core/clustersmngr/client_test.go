@@ -202,6 +202,39 @@ func TestClientClusteredListPagination(t *testing.T) {
 	g.Expect(cklist.Lists()[clusterName]).To(HaveLen(0))
 }
 
+func TestPaginationInfoEncoding(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	paginationInfo := &clustersmngr.PaginationInfo{}
+	paginationInfo.Set("cluster-a", "ns-1", "")
+	paginationInfo.Set("cluster-b", "ns-1", "")
+	g.Expect(paginationInfo.HasMore()).To(BeFalse())
+
+	paginationInfo.Set("cluster-b", "ns-2", "some-token")
+	g.Expect(paginationInfo.HasMore()).To(BeTrue())
+
+	continueToken, err := clustersmngr.EncodePaginationInfo(paginationInfo)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	decoded, err := clustersmngr.DecodePaginationInfo(continueToken)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(decoded.HasMore()).To(BeTrue())
+	g.Expect(decoded.Get("cluster-b", "ns-2")).To(Equal("some-token"))
+	g.Expect(decoded.Get("cluster-a", "ns-1")).To(BeEmpty())
+
+	_, err = clustersmngr.DecodePaginationInfo("not-a-token")
+	g.Expect(err).To(HaveOccurred())
+
+	// A namespace whose first page failed is listed again from the start.
+	paginationInfo = &clustersmngr.PaginationInfo{}
+	paginationInfo.SetFailed("cluster-a", "ns-1", "")
+	g.Expect(paginationInfo.HasMore()).To(BeTrue())
+	g.Expect(paginationInfo.Continue("cluster-a", "ns-1")).To(Equal(client.Continue("")))
+
+	paginationInfo.SetFailed("cluster-a", "ns-1", "some-token")
+	g.Expect(paginationInfo.Continue("cluster-a", "ns-1")).To(Equal(client.Continue("some-token")))
+}
+
 func TestClientClusteredListClusterScoped(t *testing.T) {
 	g := NewGomegaWithT(t)
 
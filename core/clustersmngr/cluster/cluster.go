@@ -3,10 +3,12 @@ package cluster
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"os"
 	"time"
 
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"sigs.k8s.io/cli-utils/pkg/flowcontrol"
@@ -29,7 +31,7 @@ const (
 
 var (
 	kubeClientTimeout        = getEnvDuration("WEAVE_GITOPS_KUBE_CLIENT_TIMEOUT", 30*time.Second)
-	DefaultKubeConfigOptions = []KubeConfigOption{WithFlowControl}
+	DefaultKubeConfigOptions = []KubeConfigOption{WithFlowControl, WithTracing}
 )
 
 type KubeConfigOption func(*rest.Config) (*rest.Config, error)
@@ -56,6 +58,18 @@ type Cluster interface {
 	GetServerConfig() (*rest.Config, error)
 }
 
+// WithTracing traces every request made to the cluster's API server, as
+// part of the trace of the call that made it.
+func WithTracing(config *rest.Config) (*rest.Config, error) {
+	config.Wrap(func(rt http.RoundTripper) http.RoundTripper {
+		return otelhttp.NewTransport(rt, otelhttp.WithSpanNameFormatter(func(_ string, r *http.Request) string {
+			return "kubernetes " + r.Method
+		}))
+	})
+
+	return config, nil
+}
+
 func WithFlowControl(config *rest.Config) (*rest.Config, error) {
 	// flowcontrol.IsEnabled makes a request to the K8s API of the cluster stored in the config.
 	// It does a HEAD request to /livez/ping which uses the config.Dial timeout. We can use this
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sync"
 
+	"github.com/hashicorp/go-multierror"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -27,21 +28,62 @@ func (e ClusterNotFoundError) Error() string {
 	return fmt.Sprintf("cluster=%s not found", e.Cluster)
 }
 
-type clusterFetchers []ClusterFetcher
+// clusterFetchers fetches the clusters of all the fetchers, remembering what
+// each of them last returned.
+type clusterFetchers struct {
+	fetchers []ClusterFetcher
+
+	lock        sync.Mutex
+	lastFetched [][]cluster.Cluster
+}
+
+func newClusterFetchers(fetchers []ClusterFetcher) *clusterFetchers {
+	return &clusterFetchers{
+		fetchers:    fetchers,
+		lastFetched: make([][]cluster.Cluster, len(fetchers)),
+	}
+}
+
+// Fetch returns the clusters of every fetcher, along with the errors of the
+// ones that failed. A failing fetcher keeps the clusters it last returned, so
+// its clusters don't disappear while, say, the API server is briefly
+// unavailable, and it doesn't take the clusters of the others with it.
+//
+// Cluster names have to be unique, so when fetchers return clusters with the
+// same name only the one from the first fetcher is kept, and the collision is
+// reported as an error. That way a kubeconfig secret can't shadow the
+// management cluster, as its fetcher comes first.
+func (cf *clusterFetchers) Fetch(ctx context.Context) ([]cluster.Cluster, error) {
+	cf.lock.Lock()
+	defer cf.lock.Unlock()
 
-func (fetchers clusterFetchers) Fetch(ctx context.Context) ([]cluster.Cluster, error) {
 	clusters := []cluster.Cluster{}
+	names := map[string]bool{}
 
-	for _, fetcher := range fetchers {
-		additionalClusters, err := fetcher.Fetch(ctx)
+	var result *multierror.Error
+
+	for i, fetcher := range cf.fetchers {
+		fetched, err := fetcher.Fetch(ctx)
 		if err != nil {
-			return nil, err
+			result = multierror.Append(result, err)
+			fetched = cf.lastFetched[i]
+		} else {
+			cf.lastFetched[i] = fetched
 		}
 
-		clusters = append(clusters, additionalClusters...)
+		for _, c := range fetched {
+			if names[c.GetName()] {
+				result = multierror.Append(result, fmt.Errorf("ignoring cluster %q, a cluster with the same name was already fetched", c.GetName()))
+				continue
+			}
+
+			names[c.GetName()] = true
+
+			clusters = append(clusters, c)
+		}
 	}
 
-	return clusters, nil
+	return clusters, result.ErrorOrNil()
 }
 
 // ClusterFetcher fetches all leaf clusters
@@ -51,6 +93,15 @@ type ClusterFetcher interface {
 	Fetch(ctx context.Context) ([]cluster.Cluster, error)
 }
 
+// WatchingClusterFetcher is a ClusterFetcher that can also notice changes
+// to the clusters it fetches, and trigger an update of the manager as soon
+// as they happen instead of waiting for the next poll.
+type WatchingClusterFetcher interface {
+	ClusterFetcher
+	// Watch blocks until ctx is done.
+	Watch(ctx context.Context, manager ClustersManager)
+}
+
 // ClientsPool stores all clients to the leaf clusters
 //
 //counterfeiter:generate . ClientsPool
@@ -90,6 +90,17 @@ type FakeClustersManager struct {
 		result1 clustersmngr.Client
 		result2 error
 	}
+	GetUserAccessibleKindsStub        func(*auth.UserPrincipal) map[string]map[string][]string
+	getUserAccessibleKindsMutex       sync.RWMutex
+	getUserAccessibleKindsArgsForCall []struct {
+		arg1 *auth.UserPrincipal
+	}
+	getUserAccessibleKindsReturns struct {
+		result1 map[string]map[string][]string
+	}
+	getUserAccessibleKindsReturnsOnCall map[int]struct {
+		result1 map[string]map[string][]string
+	}
 	GetUserNamespacesStub        func(*auth.UserPrincipal) map[string][]v1.Namespace
 	getUserNamespacesMutex       sync.RWMutex
 	getUserNamespacesArgsForCall []struct {
@@ -520,6 +531,67 @@ func (fake *FakeClustersManager) GetServerClientReturnsOnCall(i int, result1 clu
 	}{result1, result2}
 }
 
+func (fake *FakeClustersManager) GetUserAccessibleKinds(arg1 *auth.UserPrincipal) map[string]map[string][]string {
+	fake.getUserAccessibleKindsMutex.Lock()
+	ret, specificReturn := fake.getUserAccessibleKindsReturnsOnCall[len(fake.getUserAccessibleKindsArgsForCall)]
+	fake.getUserAccessibleKindsArgsForCall = append(fake.getUserAccessibleKindsArgsForCall, struct {
+		arg1 *auth.UserPrincipal
+	}{arg1})
+	stub := fake.GetUserAccessibleKindsStub
+	fakeReturns := fake.getUserAccessibleKindsReturns
+	fake.recordInvocation("GetUserAccessibleKinds", []interface{}{arg1})
+	fake.getUserAccessibleKindsMutex.Unlock()
+	if stub != nil {
+		return stub(arg1)
+	}
+	if specificReturn {
+		return ret.result1
+	}
+	return fakeReturns.result1
+}
+
+func (fake *FakeClustersManager) GetUserAccessibleKindsCallCount() int {
+	fake.getUserAccessibleKindsMutex.RLock()
+	defer fake.getUserAccessibleKindsMutex.RUnlock()
+	return len(fake.getUserAccessibleKindsArgsForCall)
+}
+
+func (fake *FakeClustersManager) GetUserAccessibleKindsCalls(stub func(*auth.UserPrincipal) map[string]map[string][]string) {
+	fake.getUserAccessibleKindsMutex.Lock()
+	defer fake.getUserAccessibleKindsMutex.Unlock()
+	fake.GetUserAccessibleKindsStub = stub
+}
+
+func (fake *FakeClustersManager) GetUserAccessibleKindsArgsForCall(i int) *auth.UserPrincipal {
+	fake.getUserAccessibleKindsMutex.RLock()
+	defer fake.getUserAccessibleKindsMutex.RUnlock()
+	argsForCall := fake.getUserAccessibleKindsArgsForCall[i]
+	return argsForCall.arg1
+}
+
+func (fake *FakeClustersManager) GetUserAccessibleKindsReturns(result1 map[string]map[string][]string) {
+	fake.getUserAccessibleKindsMutex.Lock()
+	defer fake.getUserAccessibleKindsMutex.Unlock()
+	fake.GetUserAccessibleKindsStub = nil
+	fake.getUserAccessibleKindsReturns = struct {
+		result1 map[string]map[string][]string
+	}{result1}
+}
+
+func (fake *FakeClustersManager) GetUserAccessibleKindsReturnsOnCall(i int, result1 map[string]map[string][]string) {
+	fake.getUserAccessibleKindsMutex.Lock()
+	defer fake.getUserAccessibleKindsMutex.Unlock()
+	fake.GetUserAccessibleKindsStub = nil
+	if fake.getUserAccessibleKindsReturnsOnCall == nil {
+		fake.getUserAccessibleKindsReturnsOnCall = make(map[int]struct {
+			result1 map[string]map[string][]string
+		})
+	}
+	fake.getUserAccessibleKindsReturnsOnCall[i] = struct {
+		result1 map[string]map[string][]string
+	}{result1}
+}
+
 func (fake *FakeClustersManager) GetUserNamespaces(arg1 *auth.UserPrincipal) map[string][]v1.Namespace {
 	fake.getUserNamespacesMutex.Lock()
 	ret, specificReturn := fake.getUserNamespacesReturnsOnCall[len(fake.getUserNamespacesArgsForCall)]
@@ -540,6 +612,8 @@ func (fake *FakeClustersManager) GetUserNamespaces(arg1 *auth.UserPrincipal) map
 }
 
 func (fake *FakeClustersManager) GetUserNamespacesCallCount() int {
+	fake.getUserAccessibleKindsMutex.RLock()
+	defer fake.getUserAccessibleKindsMutex.RUnlock()
 	fake.getUserNamespacesMutex.RLock()
 	defer fake.getUserNamespacesMutex.RUnlock()
 	return len(fake.getUserNamespacesArgsForCall)
@@ -552,6 +626,8 @@ func (fake *FakeClustersManager) GetUserNamespacesCalls(stub func(*auth.UserPrin
 }
 
 func (fake *FakeClustersManager) GetUserNamespacesArgsForCall(i int) *auth.UserPrincipal {
+	fake.getUserAccessibleKindsMutex.RLock()
+	defer fake.getUserAccessibleKindsMutex.RUnlock()
 	fake.getUserNamespacesMutex.RLock()
 	defer fake.getUserNamespacesMutex.RUnlock()
 	argsForCall := fake.getUserNamespacesArgsForCall[i]
@@ -868,6 +944,8 @@ func (fake *FakeClustersManager) Invocations() map[string][][]interface{} {
 	defer fake.getImpersonatedDiscoveryClientMutex.RUnlock()
 	fake.getServerClientMutex.RLock()
 	defer fake.getServerClientMutex.RUnlock()
+	fake.getUserAccessibleKindsMutex.RLock()
+	defer fake.getUserAccessibleKindsMutex.RUnlock()
 	fake.getUserNamespacesMutex.RLock()
 	defer fake.getUserNamespacesMutex.RUnlock()
 	fake.removeWatcherMutex.RLock()
@@ -158,6 +158,10 @@ type ClustersManager interface {
 	GetClustersNamespaces() map[string][]v1.Namespace
 	// GetUserNamespaces returns the accessible namespaces for the user
 	GetUserNamespaces(user *auth.UserPrincipal) map[string][]v1.Namespace
+	// GetUserAccessibleKinds returns the kinds the user can read in each of
+	// their namespaces, keyed by cluster and then namespace. Clusters that
+	// aren't in the map don't restrict the kinds the user can read.
+	GetUserAccessibleKinds(user *auth.UserPrincipal) map[string]map[string][]string
 	// Start starts go routines to keep clusters and namespaces lists up to date
 	Start(ctx context.Context)
 	// Subscribe returns a new ClustersWatcher
@@ -169,7 +173,7 @@ type ClustersManager interface {
 }
 
 type clustersManager struct {
-	clustersFetchers clusterFetchers
+	clustersFetchers *clusterFetchers
 	nsChecker        nsaccess.Checker
 	log              logr.Logger
 
@@ -181,7 +185,9 @@ type clustersManager struct {
 	clustersNamespaces *ClustersNamespaces
 	// lists of namespaces accessible by the user on every cluster
 	usersNamespaces *UsersNamespaces
-	usersClients    *UsersClients
+	// kinds of object readable by the user in each of their namespaces
+	usersAccessibleKinds *UsersAccessibleKinds
+	usersClients         *UsersClients
 
 	initialClustersLoad chan bool
 	// list of watchers to notify of clusters updates
@@ -223,11 +229,12 @@ func NewClustersManager(fetchers []ClusterFetcher, nsChecker nsaccess.Checker, l
 	logger.Info("Use user client for namespaces", "enabled", useUserClientForNamespaces)
 
 	return &clustersManager{
-		clustersFetchers:           fetchers,
+		clustersFetchers:           newClusterFetchers(fetchers),
 		nsChecker:                  nsChecker,
 		clusters:                   &Clusters{},
 		clustersNamespaces:         &ClustersNamespaces{},
 		usersNamespaces:            &UsersNamespaces{Cache: ttlcache.New(userNamespaceResolution)},
+		usersAccessibleKinds:       &UsersAccessibleKinds{Cache: ttlcache.New(userNamespaceResolution)},
 		usersClients:               &UsersClients{Cache: ttlcache.New(usersClientResolution)},
 		log:                        logger,
 		initialClustersLoad:        make(chan bool),
@@ -263,6 +270,12 @@ func (cf *clustersManager) GetClusters() []cluster.Cluster {
 func (cf *clustersManager) Start(ctx context.Context) {
 	go cf.watchClusters(ctx)
 
+	for _, fetcher := range cf.clustersFetchers.fetchers {
+		if wf, ok := fetcher.(WatchingClusterFetcher); ok {
+			go wf.Watch(ctx, cf)
+		}
+	}
+
 	if !cf.useUserClientForNamespaces {
 		go cf.watchNamespaces(ctx)
 	}
@@ -288,10 +301,9 @@ func (cf *clustersManager) watchClusters(ctx context.Context) {
 
 // UpdateClusters updates the clusters list and notifies the registered watchers.
 func (cf *clustersManager) UpdateClusters(ctx context.Context) error {
-	clusters, err := cf.clustersFetchers.Fetch(ctx)
-	if err != nil {
-		return fmt.Errorf("failed to fetch clusters: %w", err)
-	}
+	// Fetchers that failed keep their last clusters, the error is still
+	// returned so it gets reported.
+	clusters, fetchErr := cf.clustersFetchers.Fetch(ctx)
 
 	addedClusters, removedClusters := cf.clusters.Set(clusters)
 
@@ -305,6 +317,10 @@ func (cf *clustersManager) UpdateClusters(ctx context.Context) error {
 		}
 	}
 
+	if fetchErr != nil {
+		return fmt.Errorf("failed to fetch clusters: %w", fetchErr)
+	}
+
 	return nil
 }
 
@@ -398,6 +414,7 @@ func (cf *clustersManager) syncCaches() {
 		cf.log.Info("Clearing namespace caches")
 		cf.clustersNamespaces.Clear()
 		cf.usersNamespaces.Clear()
+		cf.usersAccessibleKinds.Clear()
 		cf.clustersHash = newHash
 	}
 }
@@ -561,13 +578,24 @@ func (cf *clustersManager) UpdateUserNamespaces(ctx context.Context, user *auth.
 				return
 			}
 
-			filteredNs, err := cf.nsChecker.FilterAccessibleNamespaces(ctx, clientset.AuthorizationV1(), clusterNs)
+			var (
+				filteredNs []v1.Namespace
+				kinds      map[string][]string
+			)
+
+			if kindsChecker, ok := cf.nsChecker.(nsaccess.KindsChecker); ok {
+				filteredNs, kinds, err = kindsChecker.FilterAccessibleNamespacesAndKinds(ctx, clientset.AuthorizationV1(), clusterNs)
+			} else {
+				filteredNs, err = cf.nsChecker.FilterAccessibleNamespaces(ctx, clientset.AuthorizationV1(), clusterNs)
+			}
+
 			if err != nil {
 				cf.log.Error(err, "failed filtering namespaces", "cluster", cluster.GetName(), "user", user.ID)
 				return
 			}
 
 			cf.usersNamespaces.Set(user, cluster.GetName(), filteredNs)
+			cf.usersAccessibleKinds.Set(user, cluster.GetName(), kinds)
 		}(cl)
 	}
 
@@ -578,6 +606,10 @@ func (cf *clustersManager) GetUserNamespaces(user *auth.UserPrincipal) map[strin
 	return cf.usersNamespaces.GetAll(user, cf.clusters.Get())
 }
 
+func (cf *clustersManager) GetUserAccessibleKinds(user *auth.UserPrincipal) map[string]map[string][]string {
+	return cf.usersAccessibleKinds.GetAll(user, cf.clusters.Get())
+}
+
 func (cf *clustersManager) userNsList(ctx context.Context, user *auth.UserPrincipal) map[string][]v1.Namespace {
 	userNamespaces := cf.GetUserNamespaces(user)
 	if len(userNamespaces) > 0 {
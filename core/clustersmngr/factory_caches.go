@@ -151,6 +151,49 @@ func (un UsersNamespaces) cacheKey(user *auth.UserPrincipal, cluster string) uin
 	return ttlcache.StringKey(fmt.Sprintf("%s:%s", user.ID, cluster))
 }
 
+// UsersAccessibleKinds caches the kinds of object each user can read in
+// each namespace of each cluster, when the namespace access rules restrict
+// them.
+type UsersAccessibleKinds struct {
+	Cache *ttlcache.Cache
+}
+
+func (uk *UsersAccessibleKinds) Get(user *auth.UserPrincipal, cluster string) (map[string][]string, bool) {
+	if val, found := uk.Cache.Get(uk.cacheKey(user, cluster)); found {
+		return val.(map[string][]string), true
+	}
+
+	return nil, false
+}
+
+// Set records the kinds the user can read, keyed by namespace. nil kinds
+// mean the user can read all kinds in the cluster.
+func (uk *UsersAccessibleKinds) Set(user *auth.UserPrincipal, cluster string, kinds map[string][]string) {
+	uk.Cache.Set(uk.cacheKey(user, cluster), kinds, userNamespaceTTL)
+}
+
+// GetAll returns the kinds the user can read on the clusters that restrict
+// them, keyed by cluster and then namespace.
+func (uk *UsersAccessibleKinds) GetAll(user *auth.UserPrincipal, clusters []cluster.Cluster) map[string]map[string][]string {
+	kinds := map[string]map[string][]string{}
+
+	for _, cluster := range clusters {
+		if clusterKinds, found := uk.Get(user, cluster.GetName()); found && clusterKinds != nil {
+			kinds[cluster.GetName()] = clusterKinds
+		}
+	}
+
+	return kinds
+}
+
+func (uk *UsersAccessibleKinds) Clear() {
+	uk.Cache.Clear()
+}
+
+func (uk UsersAccessibleKinds) cacheKey(user *auth.UserPrincipal, cluster string) uint64 {
+	return ttlcache.StringKey(fmt.Sprintf("%s:%s", user.ID, cluster))
+}
+
 type UsersClients struct {
 	Cache *ttlcache.Cache
 }
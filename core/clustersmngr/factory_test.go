@@ -18,6 +18,7 @@ import (
 	"golang.org/x/net/context"
 	v1 "k8s.io/api/core/v1"
 	"k8s.io/client-go/kubernetes"
+	typedauth "k8s.io/client-go/kubernetes/typed/authorization/v1"
 )
 
 func TestGetImpersonatedClient(t *testing.T) {
@@ -258,6 +259,55 @@ func TestUpdateUserNamespaces(t *testing.T) {
 	})
 }
 
+// kindsChecker gives access to every namespace, and returns the same kinds
+// for every cluster.
+type kindsChecker struct {
+	nsaccessfakes.FakeChecker
+	kinds map[string][]string
+}
+
+func (c *kindsChecker) FilterAccessibleNamespacesAndKinds(ctx context.Context, auth typedauth.AuthorizationV1Interface, namespaces []v1.Namespace) ([]v1.Namespace, map[string][]string, error) {
+	return namespaces, c.kinds, nil
+}
+
+func TestUpdateUserNamespacesRecordsAccessibleKinds(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger := logr.Discard()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c1 := makeLeafCluster(t, "foo")
+	u1 := &auth.UserPrincipal{ID: "drstrange"}
+	u2 := &auth.UserPrincipal{ID: "wong"}
+
+	clustersFetcher := new(clustersmngrfakes.FakeClusterFetcher)
+	clustersFetcher.FetchReturns([]cluster.Cluster{c1}, nil)
+
+	checker := &kindsChecker{kinds: map[string][]string{"apps": {"Kustomization"}}}
+
+	clustersManager := clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{clustersFetcher}, checker, logger)
+	g.Expect(clustersManager.UpdateClusters(ctx)).To(Succeed())
+
+	clustersManager.UpdateUserNamespaces(ctx, u1)
+
+	g.Expect(clustersManager.GetUserAccessibleKinds(u1)).To(Equal(map[string]map[string][]string{
+		"foo": {"apps": {"Kustomization"}},
+	}))
+
+	// Other users' kinds are kept apart.
+	g.Expect(clustersManager.GetUserAccessibleKinds(u2)).To(BeEmpty())
+
+	// A Checker without kind rules doesn't restrict the kinds.
+	clustersManager = clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{clustersFetcher}, nsaccess.NewChecker(nil), logger)
+	g.Expect(clustersManager.UpdateClusters(ctx)).To(Succeed())
+
+	clustersManager.UpdateUserNamespaces(ctx, u1)
+
+	g.Expect(clustersManager.GetUserNamespaces(u1)).To(HaveKey("foo"))
+	g.Expect(clustersManager.GetUserAccessibleKinds(u1)).To(BeEmpty())
+}
+
 func TestUpdateUserNamespacesFailsToConnect(t *testing.T) {
 	g := NewGomegaWithT(t)
 	logger := logr.Discard()
@@ -328,6 +378,64 @@ func TestGetClusters(t *testing.T) {
 	})
 }
 
+func TestUpdateClustersKeepsLastClustersOfFetchersThatFailed(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger := logr.Discard()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	managementFetcher := new(clustersmngrfakes.FakeClusterFetcher)
+	leafFetcher := new(clustersmngrfakes.FakeClusterFetcher)
+
+	clustersManager := clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{managementFetcher, leafFetcher}, &nsaccessfakes.FakeChecker{}, logger)
+
+	c1 := makeLeafCluster(t, "management")
+	c2 := makeLeafCluster(t, "leaf")
+
+	managementFetcher.FetchReturns([]cluster.Cluster{c1}, nil)
+	leafFetcher.FetchReturns(nil, fmt.Errorf("leaf clusters unavailable"))
+
+	err := clustersManager.UpdateClusters(ctx)
+	g.Expect(err).To(MatchError(ContainSubstring("leaf clusters unavailable")))
+	g.Expect(clustersManager.GetClusters()).To(Equal([]cluster.Cluster{c1}))
+
+	leafFetcher.FetchReturns([]cluster.Cluster{c2}, nil)
+	g.Expect(clustersManager.UpdateClusters(ctx)).To(Succeed())
+	g.Expect(clustersManager.GetClusters()).To(ConsistOf(c1, c2))
+
+	leafFetcher.FetchReturns(nil, fmt.Errorf("leaf clusters unavailable"))
+	err = clustersManager.UpdateClusters(ctx)
+	g.Expect(err).To(MatchError(ContainSubstring("leaf clusters unavailable")))
+	g.Expect(clustersManager.GetClusters()).To(ConsistOf(c1, c2), "expected the leaf cluster to be kept")
+}
+
+func TestUpdateClustersIgnoresClustersWithTheSameName(t *testing.T) {
+	g := NewGomegaWithT(t)
+	logger := logr.Discard()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	managementFetcher := new(clustersmngrfakes.FakeClusterFetcher)
+	leafFetcher := new(clustersmngrfakes.FakeClusterFetcher)
+
+	clustersManager := clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{managementFetcher, leafFetcher}, &nsaccessfakes.FakeChecker{}, logger)
+
+	management := makeLeafCluster(t, "Default")
+	shadowing := makeLeafCluster(t, "Default")
+	leaf := makeLeafCluster(t, "leaf")
+
+	managementFetcher.FetchReturns([]cluster.Cluster{management}, nil)
+	leafFetcher.FetchReturns([]cluster.Cluster{shadowing, leaf}, nil)
+
+	err := clustersManager.UpdateClusters(ctx)
+	g.Expect(err).To(MatchError(ContainSubstring(`ignoring cluster "Default"`)))
+	g.Expect(clustersManager.GetClusters()).To(HaveLen(2))
+	g.Expect(clustersManager.GetClusters()).To(ContainElement(BeIdenticalTo(management)))
+	g.Expect(clustersManager.GetClusters()).NotTo(ContainElement(BeIdenticalTo(shadowing)))
+}
+
 func TestUpdateClusters(t *testing.T) {
 	g := NewGomegaWithT(t)
 	logger := logr.Discard()
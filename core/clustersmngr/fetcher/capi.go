@@ -0,0 +1,118 @@
+package fetcher
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	mngr "github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// CAPIClusterPhaseProvisioned is the phase of a Cluster API cluster that is
+	// ready to be connected to.
+	CAPIClusterPhaseProvisioned = "Provisioned"
+
+	capiKubeconfigSecretSuffix = "-kubeconfig"
+)
+
+// CAPIClusterGroupKind is the Cluster API Cluster kind. We use unstructured
+// objects to avoid pulling the whole of Cluster API in as a dependency, and
+// the version is whichever one the management cluster serves.
+var CAPIClusterGroupKind = schema.GroupKind{
+	Group: "cluster.x-k8s.io",
+	Kind:  "Cluster",
+}
+
+type capiClusterFetcher struct {
+	client            client.Client
+	namespace         string
+	scheme            *apiruntime.Scheme
+	log               logr.Logger
+	kubeConfigOptions []cluster.KubeConfigOption
+}
+
+// NewCAPIClusterFetcher returns a ClusterFetcher that discovers provisioned
+// Cluster API clusters in the given namespace, or in all namespaces if empty.
+func NewCAPIClusterFetcher(cl client.Client, namespace string, scheme *apiruntime.Scheme, log logr.Logger, kubeConfigOptions ...cluster.KubeConfigOption) mngr.ClusterFetcher {
+	return &capiClusterFetcher{
+		client:            cl,
+		namespace:         namespace,
+		scheme:            scheme,
+		log:               log.WithName("capi-cluster-fetcher"),
+		kubeConfigOptions: kubeConfigOptions,
+	}
+}
+
+func (f *capiClusterFetcher) Fetch(ctx context.Context) ([]cluster.Cluster, error) {
+	clusters := []cluster.Cluster{}
+
+	mapping, err := f.client.RESTMapper().RESTMapping(CAPIClusterGroupKind)
+	if err != nil {
+		// Cluster API isn't installed, or not yet, so there are no clusters
+		if meta.IsNoMatchError(err) {
+			return clusters, nil
+		}
+
+		return nil, fmt.Errorf("failed discovering the CAPI cluster version: %w", err)
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(mapping.GroupVersionKind.GroupVersion().WithKind(CAPIClusterGroupKind.Kind + "List"))
+
+	if err := f.client.List(ctx, list, client.InNamespace(f.namespace)); err != nil {
+		// The CRD can be removed between discovery and listing
+		if apierrors.IsNotFound(err) || meta.IsNoMatchError(err) {
+			return clusters, nil
+		}
+
+		return nil, fmt.Errorf("failed listing CAPI clusters: %w", err)
+	}
+
+	for _, capiCluster := range list.Items {
+		phase, _, _ := unstructured.NestedString(capiCluster.Object, "status", "phase")
+		if phase != CAPIClusterPhaseProvisioned {
+			continue
+		}
+
+		cl, err := f.clusterFromCAPICluster(ctx, capiCluster)
+		if err != nil {
+			f.log.Error(err, "skipping cluster", "cluster", client.ObjectKeyFromObject(&capiCluster))
+			continue
+		}
+
+		clusters = append(clusters, cl)
+	}
+
+	return clusters, nil
+}
+
+func (f *capiClusterFetcher) clusterFromCAPICluster(ctx context.Context, capiCluster unstructured.Unstructured) (cluster.Cluster, error) {
+	secret := v1.Secret{}
+	key := client.ObjectKey{
+		Namespace: capiCluster.GetNamespace(),
+		Name:      capiCluster.GetName() + capiKubeconfigSecretSuffix,
+	}
+
+	if err := f.client.Get(ctx, key, &secret); err != nil {
+		return nil, fmt.Errorf("failed getting kubeconfig secret %s: %w", key, err)
+	}
+
+	restConfig, err := restConfigFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	// Clusters are named namespace/name, the same way GitOps Run addresses vclusters
+	name := capiCluster.GetNamespace() + "/" + capiCluster.GetName()
+
+	return cluster.NewSingleCluster(name, restConfig, f.scheme, f.kubeConfigOptions...)
+}
@@ -0,0 +1,103 @@
+package fetcher_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCAPIFetcher(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := fake.NewClientBuilder().WithRESTMapper(capiRESTMapper("v1beta1")).WithObjects(
+		capiCluster("v1beta1", "ready", "clusters", fetcher.CAPIClusterPhaseProvisioned),
+		kubeconfigSecret("ready-kubeconfig", "clusters", nil, nil, testKubeconfig),
+		capiCluster("v1beta1", "provisioning", "clusters", "Provisioning"),
+		kubeconfigSecret("provisioning-kubeconfig", "clusters", nil, nil, testKubeconfig),
+		capiCluster("v1beta1", "no-secret", "clusters", fetcher.CAPIClusterPhaseProvisioned),
+		capiCluster("v1beta1", "elsewhere", "other", fetcher.CAPIClusterPhaseProvisioned),
+		kubeconfigSecret("elsewhere-kubeconfig", "other", nil, nil, testKubeconfig),
+	).Build()
+
+	t.Run("all namespaces", func(t *testing.T) {
+		f := fetcher.NewCAPIClusterFetcher(client, "", nil, logr.Discard())
+
+		clusters, err := f.Fetch(context.TODO())
+		g.Expect(err).NotTo(HaveOccurred())
+
+		names := []string{}
+		for _, c := range clusters {
+			names = append(names, c.GetName())
+		}
+
+		g.Expect(names).To(ConsistOf("clusters/ready", "other/elsewhere"))
+	})
+
+	t.Run("single namespace", func(t *testing.T) {
+		f := fetcher.NewCAPIClusterFetcher(client, "other", nil, logr.Discard())
+
+		clusters, err := f.Fetch(context.TODO())
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(clusters).To(HaveLen(1))
+		g.Expect(clusters[0].GetName()).To(Equal("other/elsewhere"))
+		g.Expect(clusters[0].GetHost()).To(Equal("https://leaf.example.com:6443"))
+	})
+}
+
+func TestCAPIFetcherUsesTheServedVersion(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := fake.NewClientBuilder().WithRESTMapper(capiRESTMapper("v1alpha4")).WithObjects(
+		capiCluster("v1alpha4", "ready", "clusters", fetcher.CAPIClusterPhaseProvisioned),
+		kubeconfigSecret("ready-kubeconfig", "clusters", nil, nil, testKubeconfig),
+	).Build()
+
+	f := fetcher.NewCAPIClusterFetcher(client, "", nil, logr.Discard())
+
+	clusters, err := f.Fetch(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clusters).To(HaveLen(1))
+	g.Expect(clusters[0].GetName()).To(Equal("clusters/ready"))
+}
+
+func TestCAPIFetcherWithoutClusterAPI(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	client := fake.NewClientBuilder().Build()
+
+	f := fetcher.NewCAPIClusterFetcher(client, "", nil, logr.Discard())
+
+	clusters, err := f.Fetch(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clusters).To(BeEmpty())
+}
+
+// capiRESTMapper maps the Cluster API cluster kind to the version the
+// management cluster serves.
+func capiRESTMapper(version string) meta.RESTMapper {
+	gv := schema.GroupVersion{Group: fetcher.CAPIClusterGroupKind.Group, Version: version}
+
+	mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{gv, corev1.SchemeGroupVersion})
+	mapper.Add(gv.WithKind(fetcher.CAPIClusterGroupKind.Kind), meta.RESTScopeNamespace)
+	mapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+
+	return mapper
+}
+
+func capiCluster(version, name, namespace, phase string) *unstructured.Unstructured {
+	u := &unstructured.Unstructured{}
+	u.SetGroupVersionKind(fetcher.CAPIClusterGroupKind.WithVersion(version))
+	u.SetName(name)
+	u.SetNamespace(namespace)
+	_ = unstructured.SetNestedField(u.Object, phase, "status", "phase")
+
+	return u
+}
@@ -0,0 +1,159 @@
+package fetcher
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	mngr "github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	apiruntime "k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// KubeconfigSecretKey is the key in the secret data holding the kubeconfig.
+	// This matches the key used by Cluster API for its kubeconfig secrets.
+	KubeconfigSecretKey = "value"
+	// ClusterNameAnnotation can be set on a kubeconfig secret to override the
+	// name the cluster is given in weave-gitops. Defaults to the secret name.
+	ClusterNameAnnotation = "clusters.weave.works/name"
+
+	watchRetryInterval = 5 * time.Second
+)
+
+type secretsClusterFetcher struct {
+	client            client.WithWatch
+	namespace         string
+	selector          labels.Selector
+	scheme            *apiruntime.Scheme
+	log               logr.Logger
+	kubeConfigOptions []cluster.KubeConfigOption
+}
+
+// NewSecretsClusterFetcher returns a ClusterFetcher that discovers leaf clusters
+// from the kubeconfig secrets in namespace matching selector.
+func NewSecretsClusterFetcher(cl client.WithWatch, namespace string, selector labels.Selector, scheme *apiruntime.Scheme, log logr.Logger, kubeConfigOptions ...cluster.KubeConfigOption) mngr.ClusterFetcher {
+	return &secretsClusterFetcher{
+		client:            cl,
+		namespace:         namespace,
+		selector:          selector,
+		scheme:            scheme,
+		log:               log.WithName("secrets-cluster-fetcher"),
+		kubeConfigOptions: kubeConfigOptions,
+	}
+}
+
+func (f *secretsClusterFetcher) Fetch(ctx context.Context) ([]cluster.Cluster, error) {
+	secrets := &v1.SecretList{}
+
+	if err := f.client.List(ctx, secrets, f.listOptions()...); err != nil {
+		return nil, fmt.Errorf("failed listing kubeconfig secrets: %w", err)
+	}
+
+	clusters := []cluster.Cluster{}
+
+	for _, secret := range secrets.Items {
+		cl, err := f.clusterFromSecret(secret)
+		if err != nil {
+			// A single broken secret shouldn't take every other cluster down with it
+			f.log.Error(err, "skipping cluster", "secret", client.ObjectKeyFromObject(&secret))
+			continue
+		}
+
+		clusters = append(clusters, cl)
+	}
+
+	return clusters, nil
+}
+
+// Watch calls UpdateClusters on the manager whenever a matching kubeconfig
+// secret is added, modified or removed, so that subscribed ClustersWatchers
+// are notified without waiting for the next poll.
+func (f *secretsClusterFetcher) Watch(ctx context.Context, manager mngr.ClustersManager) {
+	for {
+		if err := f.watchOnce(ctx, manager); err != nil {
+			f.log.Error(err, "watching kubeconfig secrets")
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRetryInterval):
+		}
+	}
+}
+
+func (f *secretsClusterFetcher) watchOnce(ctx context.Context, manager mngr.ClustersManager) error {
+	w, err := f.client.Watch(ctx, &v1.SecretList{}, f.listOptions()...)
+	if err != nil {
+		return fmt.Errorf("failed starting watch: %w", err)
+	}
+	defer w.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case ev, ok := <-w.ResultChan():
+			if !ok {
+				return nil
+			}
+
+			switch ev.Type {
+			case watch.Added, watch.Modified, watch.Deleted:
+				if err := manager.UpdateClusters(ctx); err != nil {
+					f.log.Error(err, "failed updating clusters")
+				}
+			case watch.Error:
+				return fmt.Errorf("watch error: %v", ev.Object)
+			}
+		}
+	}
+}
+
+func (f *secretsClusterFetcher) listOptions() []client.ListOption {
+	opts := []client.ListOption{
+		client.InNamespace(f.namespace),
+	}
+
+	if f.selector != nil {
+		opts = append(opts, client.MatchingLabelsSelector{Selector: f.selector})
+	}
+
+	return opts
+}
+
+func (f *secretsClusterFetcher) clusterFromSecret(secret v1.Secret) (cluster.Cluster, error) {
+	restConfig, err := restConfigFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	name := secret.Name
+	if override := secret.Annotations[ClusterNameAnnotation]; override != "" {
+		name = override
+	}
+
+	return cluster.NewSingleCluster(name, restConfig, f.scheme, f.kubeConfigOptions...)
+}
+
+func restConfigFromSecret(secret v1.Secret) (*rest.Config, error) {
+	data, ok := secret.Data[KubeconfigSecretKey]
+	if !ok || len(data) == 0 {
+		return nil, fmt.Errorf("secret has no %q key", KubeconfigSecretKey)
+	}
+
+	restConfig, err := clientcmd.RESTConfigFromKubeConfig(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed parsing kubeconfig: %w", err)
+	}
+
+	return restConfig, nil
+}
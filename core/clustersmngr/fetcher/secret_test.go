@@ -0,0 +1,78 @@
+package fetcher_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const testKubeconfig = `apiVersion: v1
+kind: Config
+clusters:
+- name: leaf
+  cluster:
+    server: https://leaf.example.com:6443
+contexts:
+- name: leaf
+  context:
+    cluster: leaf
+    user: leaf
+current-context: leaf
+users:
+- name: leaf
+  user:
+    token: my-token
+`
+
+func TestSecretsFetcher(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	selector := labels.SelectorFromSet(labels.Set{"weave.works/cluster": "true"})
+
+	client := fake.NewClientBuilder().WithObjects(
+		kubeconfigSecret("leaf-1", "flux-system", map[string]string{"weave.works/cluster": "true"}, nil, testKubeconfig),
+		kubeconfigSecret("leaf-2", "flux-system", map[string]string{"weave.works/cluster": "true"}, map[string]string{fetcher.ClusterNameAnnotation: "production"}, testKubeconfig),
+		kubeconfigSecret("broken", "flux-system", map[string]string{"weave.works/cluster": "true"}, nil, "not a kubeconfig"),
+		kubeconfigSecret("unlabelled", "flux-system", nil, nil, testKubeconfig),
+		kubeconfigSecret("other-ns", "default", map[string]string{"weave.works/cluster": "true"}, nil, testKubeconfig),
+	).Build()
+
+	f := fetcher.NewSecretsClusterFetcher(client, "flux-system", selector, nil, logr.Discard())
+
+	_, ok := f.(clustersmngr.WatchingClusterFetcher)
+	g.Expect(ok).To(BeTrue())
+
+	clusters, err := f.Fetch(context.TODO())
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clusters).To(HaveLen(2))
+
+	names := []string{}
+	for _, c := range clusters {
+		names = append(names, c.GetName())
+		g.Expect(c.GetHost()).To(Equal("https://leaf.example.com:6443"))
+	}
+
+	g.Expect(names).To(ConsistOf("leaf-1", "production"))
+}
+
+func kubeconfigSecret(name, namespace string, lbls, annotations map[string]string, kubeconfig string) *v1.Secret {
+	return &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   namespace,
+			Labels:      lbls,
+			Annotations: annotations,
+		},
+		Data: map[string][]byte{
+			fetcher.KubeconfigSecretKey: []byte(kubeconfig),
+		},
+	}
+}
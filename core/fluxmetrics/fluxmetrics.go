@@ -0,0 +1,242 @@
+// Package fluxmetrics exports Prometheus metrics about the health of the
+// Flux objects on every cluster the server knows about.
+package fluxmetrics
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-logr/logr"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	StatusReady     = "ready"
+	StatusNotReady  = "not_ready"
+	StatusSuspended = "suspended"
+
+	// DefaultRefreshInterval is how often the Flux objects are listed.
+	DefaultRefreshInterval = time.Minute
+
+	refreshTimeout = 30 * time.Second
+)
+
+// Kinds are the Flux kinds the metrics are reported for.
+var Kinds = []schema.GroupVersionKind{
+	kustomizev1.GroupVersion.WithKind(kustomizev1.KustomizationKind),
+	helmv2.GroupVersion.WithKind(helmv2.HelmReleaseKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.GitRepositoryKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.OCIRepositoryKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.HelmRepositoryKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.HelmChartKind),
+	sourcev1.GroupVersion.WithKind(sourcev1.BucketKind),
+}
+
+var (
+	objectsDesc = prometheus.NewDesc(
+		"gitops_flux_objects",
+		"Number of Flux objects, by cluster, kind and status. Suspended objects are only counted as suspended.",
+		[]string{"cluster", "kind", "status"}, nil,
+	)
+	secondsSinceReadyDesc = prometheus.NewDesc(
+		"gitops_flux_object_seconds_since_ready",
+		"Seconds since the Flux object was last reconciled successfully, 0 while it's ready. Objects that have never been ready count from when they were created.",
+		[]string{"cluster", "kind", "namespace", "name"}, nil,
+	)
+)
+
+// Collector lists the Flux objects on every cluster on an interval, using
+// the server's clients so that only objects the server can see are counted.
+// Scrapes are served from the last list, so they don't put any load on the
+// clusters.
+type Collector struct {
+	clustersManager clustersmngr.ClustersManager
+	log             logr.Logger
+
+	mu      sync.RWMutex
+	metrics []prometheus.Metric
+}
+
+// NewCollector creates a Collector for the clusters the manager knows about.
+// It reports nothing until it's been refreshed.
+func NewCollector(clustersManager clustersmngr.ClustersManager, log logr.Logger) *Collector {
+	return &Collector{
+		clustersManager: clustersManager,
+		log:             log.WithName("flux-metrics"),
+	}
+}
+
+// Start refreshes the metrics straight away, then every interval until the
+// context is done.
+func (c *Collector) Start(ctx context.Context, interval time.Duration) {
+	go func() {
+		ticker := time.NewTicker(interval)
+		defer ticker.Stop()
+
+		for {
+			c.Refresh(ctx)
+
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+		}
+	}()
+}
+
+func (c *Collector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- objectsDesc
+	ch <- secondsSinceReadyDesc
+}
+
+func (c *Collector) Collect(ch chan<- prometheus.Metric) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, m := range c.metrics {
+		ch <- m
+	}
+}
+
+// Refresh lists the Flux objects on every cluster, and replaces the metrics
+// reported on scrape.
+func (c *Collector) Refresh(ctx context.Context) {
+	ctx, cancel := context.WithTimeout(ctx, refreshTimeout)
+	defer cancel()
+
+	clustersClient, err := c.clustersManager.GetServerClient(ctx)
+	if err != nil {
+		// The client still has the clusters that could be reached.
+		c.log.Error(err, "failed getting clients for flux metrics")
+	}
+
+	if clustersClient == nil {
+		return
+	}
+
+	now := time.Now()
+	metrics := []prometheus.Metric{}
+
+	for _, gvk := range Kinds {
+		clist := clustersmngr.NewClusteredList(func() client.ObjectList {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+
+			return list
+		})
+
+		failed := map[string]bool{}
+
+		if err := clustersClient.ClusteredList(ctx, clist, false); err != nil {
+			// The lists for the other clusters are still filled in. Not every
+			// cluster has every kind installed, so this is expected.
+			c.log.V(logger.LogLevelDebug).Info("failed listing flux objects for metrics", "kind", gvk.Kind, "error", err)
+
+			var listErrs clustersmngr.ClusteredListError
+			if errors.As(err, &listErrs) {
+				for _, e := range listErrs.Errors {
+					failed[e.Cluster] = true
+				}
+			}
+		}
+
+		for cluster, lists := range clist.Lists() {
+			// Don't report there being none of a kind that couldn't be listed.
+			if failed[cluster] {
+				continue
+			}
+
+			counts := map[string]int{StatusReady: 0, StatusNotReady: 0, StatusSuspended: 0}
+
+			for _, l := range lists {
+				list, ok := l.(*unstructured.UnstructuredList)
+				if !ok {
+					continue
+				}
+
+				for i := range list.Items {
+					obj := &list.Items[i]
+					status, since := objectStatus(obj, now)
+					counts[status]++
+
+					metrics = append(metrics, prometheus.MustNewConstMetric(secondsSinceReadyDesc, prometheus.GaugeValue,
+						since.Seconds(), cluster, gvk.Kind, obj.GetNamespace(), obj.GetName()))
+				}
+			}
+
+			for status, count := range counts {
+				metrics = append(metrics, prometheus.MustNewConstMetric(objectsDesc, prometheus.GaugeValue,
+					float64(count), cluster, gvk.Kind, status))
+			}
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.metrics = metrics
+}
+
+// objectStatus returns the status of the object, and how long it's been
+// since it was last ready.
+func objectStatus(obj *unstructured.Unstructured, now time.Time) (string, time.Duration) {
+	ready, readySince := readyCondition(obj)
+
+	var since time.Duration
+
+	switch {
+	case ready:
+	case readySince.IsZero():
+		since = now.Sub(obj.GetCreationTimestamp().Time)
+	default:
+		since = now.Sub(readySince)
+	}
+
+	if suspended, _, _ := unstructured.NestedBool(obj.Object, "spec", "suspend"); suspended {
+		return StatusSuspended, since
+	}
+
+	if ready {
+		return StatusReady, since
+	}
+
+	return StatusNotReady, since
+}
+
+// readyCondition returns whether the object is ready, and when its Ready
+// condition last changed.
+func readyCondition(obj *unstructured.Unstructured) (bool, time.Time) {
+	conditions, _, _ := unstructured.NestedSlice(obj.Object, "status", "conditions")
+
+	for _, c := range conditions {
+		condition, ok := c.(map[string]interface{})
+		if !ok || condition["type"] != meta.ReadyCondition {
+			continue
+		}
+
+		var transition time.Time
+
+		if s, ok := condition["lastTransitionTime"].(string); ok {
+			if t, err := time.Parse(time.RFC3339, s); err == nil {
+				transition = t
+			}
+		}
+
+		return condition["status"] == string(metav1.ConditionTrue), transition
+	}
+
+	return false, time.Time{}
+}
@@ -0,0 +1,153 @@
+package fluxmetrics
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/clustersmngrfakes"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestCollector(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme := runtime.NewScheme()
+	g.Expect(kustomizev1.AddToScheme(scheme)).To(Succeed())
+	g.Expect(helmv2.AddToScheme(scheme)).To(Succeed())
+	g.Expect(sourcev1.AddToScheme(scheme)).To(Succeed())
+
+	ready := metav1.Condition{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, LastTransitionTime: metav1.Now()}
+	notReady := metav1.Condition{Type: meta.ReadyCondition, Status: metav1.ConditionFalse, LastTransitionTime: metav1.Now()}
+
+	kustomization := func(name string, suspend bool, condition metav1.Condition) *kustomizev1.Kustomization {
+		return &kustomizev1.Kustomization{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "flux-system"},
+			Spec:       kustomizev1.KustomizationSpec{Suspend: suspend},
+			Status:     kustomizev1.KustomizationStatus{Conditions: []metav1.Condition{condition}},
+		}
+	}
+
+	defaultClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		kustomization("apps", false, ready),
+		kustomization("infra", false, ready),
+		kustomization("broken", false, notReady),
+		kustomization("paused", true, ready),
+	).Build()
+	leafClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		kustomization("apps", false, notReady),
+	).Build()
+
+	pool := &clustersmngrfakes.FakeClientsPool{}
+	pool.ClientsReturns(map[string]client.Client{"Default": defaultClient, "leaf": leafClient})
+
+	clustersManager := &clustersmngrfakes.FakeClustersManager{}
+	clustersManager.GetServerClientReturns(clustersmngr.NewClient(pool, nil), nil)
+
+	collector := NewCollector(clustersManager, logr.Discard())
+
+	reg := prometheus.NewRegistry()
+	g.Expect(reg.Register(collector)).To(Succeed())
+
+	families, err := reg.Gather()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(families).To(BeEmpty())
+
+	collector.Refresh(context.Background())
+
+	families, err = reg.Gather()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// Scrapes are served from the last refresh.
+	_, err = reg.Gather()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(clustersManager.GetServerClientCallCount()).To(Equal(1))
+
+	counts := map[string]float64{}
+	sinceReady := map[string]float64{}
+
+	for _, f := range families {
+		for _, m := range f.GetMetric() {
+			labels := map[string]string{}
+			for _, l := range m.GetLabel() {
+				labels[l.GetName()] = l.GetValue()
+			}
+
+			switch f.GetName() {
+			case "gitops_flux_objects":
+				counts[labels["cluster"]+"/"+labels["kind"]+"/"+labels["status"]] = m.GetGauge().GetValue()
+			case "gitops_flux_object_seconds_since_ready":
+				sinceReady[labels["cluster"]+"/"+labels["name"]] = m.GetGauge().GetValue()
+			}
+		}
+	}
+
+	g.Expect(counts).To(HaveKeyWithValue("Default/Kustomization/ready", 2.0))
+	g.Expect(counts).To(HaveKeyWithValue("Default/Kustomization/not_ready", 1.0))
+	g.Expect(counts).To(HaveKeyWithValue("Default/Kustomization/suspended", 1.0))
+	g.Expect(counts).To(HaveKeyWithValue("leaf/Kustomization/not_ready", 1.0))
+	g.Expect(counts).To(HaveKeyWithValue("leaf/HelmRelease/ready", 0.0))
+
+	g.Expect(sinceReady).To(HaveLen(5))
+	g.Expect(sinceReady).To(HaveKeyWithValue("Default/apps", 0.0))
+	g.Expect(sinceReady["leaf/apps"]).To(BeNumerically(">=", 0))
+}
+
+func TestObjectStatus(t *testing.T) {
+	now := time.Date(2022, 10, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		object     string
+		wantStatus string
+		wantSince  time.Duration
+	}{
+		{
+			name:       "ready",
+			object:     `{"status": {"conditions": [{"type": "Ready", "status": "True", "lastTransitionTime": "2022-10-01T11:00:00Z"}]}}`,
+			wantStatus: StatusReady,
+		},
+		{
+			name:       "not ready since the condition changed",
+			object:     `{"status": {"conditions": [{"type": "Ready", "status": "False", "lastTransitionTime": "2022-10-01T11:00:00Z"}]}}`,
+			wantStatus: StatusNotReady,
+			wantSince:  time.Hour,
+		},
+		{
+			name:       "never ready counts from creation",
+			object:     `{"metadata": {"creationTimestamp": "2022-10-01T11:30:00Z"}}`,
+			wantStatus: StatusNotReady,
+			wantSince:  30 * time.Minute,
+		},
+		{
+			name:       "suspended",
+			object:     `{"spec": {"suspend": true}, "status": {"conditions": [{"type": "Ready", "status": "True", "lastTransitionTime": "2022-10-01T11:00:00Z"}]}}`,
+			wantStatus: StatusSuspended,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+
+			obj := &unstructured.Unstructured{}
+			g.Expect(obj.UnmarshalJSON([]byte(`{"apiVersion": "v1", "kind": "Test", ` + tt.object[1:]))).To(Succeed())
+
+			status, since := objectStatus(obj, now)
+			g.Expect(status).To(Equal(tt.wantStatus))
+			g.Expect(since).To(Equal(tt.wantSince))
+		})
+	}
+}
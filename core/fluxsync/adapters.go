@@ -4,9 +4,13 @@ import (
 	"errors"
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	imageautomationv1 "github.com/fluxcd/image-automation-controller/api/v1beta1"
+	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta1"
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
 	"github.com/fluxcd/pkg/apis/meta"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -52,6 +56,16 @@ func NewReconcileable(obj client.Object) Reconcilable {
 		return HelmChartAdapter{HelmChart: o}
 	case *sourcev1.OCIRepository:
 		return OCIRepositoryAdapter{OCIRepository: o}
+	case *reflectorv1.ImageRepository:
+		return ImageRepositoryAdapter{ImageRepository: o}
+	case *reflectorv1.ImagePolicy:
+		return ImagePolicyAdapter{ImagePolicy: o}
+	case *imageautomationv1.ImageUpdateAutomation:
+		return ImageUpdateAutomationAdapter{ImageUpdateAutomation: o}
+	case *notificationv1.Provider:
+		return ProviderAdapter{Provider: o}
+	case *notificationv1.Alert:
+		return AlertAdapter{Alert: o}
 	}
 
 	return nil
@@ -245,6 +259,232 @@ func (obj KustomizationAdapter) DeepCopyClientObject() client.Object {
 	return obj.DeepCopy()
 }
 
+type ImageRepositoryAdapter struct {
+	*reflectorv1.ImageRepository
+}
+
+func (obj ImageRepositoryAdapter) GetConditions() []metav1.Condition {
+	return obj.Status.Conditions
+}
+
+func (obj ImageRepositoryAdapter) SetConditions(conditions []metav1.Condition) {
+	obj.Status.Conditions = conditions
+}
+
+func (obj ImageRepositoryAdapter) GetLastHandledReconcileRequest() string {
+	return obj.Status.GetLastHandledReconcileRequest()
+}
+
+func (obj ImageRepositoryAdapter) AsClientObject() client.Object {
+	return obj.ImageRepository
+}
+
+func (obj ImageRepositoryAdapter) GroupVersionKind() schema.GroupVersionKind {
+	return reflectorv1.GroupVersion.WithKind(reflectorv1.ImageRepositoryKind)
+}
+
+func (obj ImageRepositoryAdapter) SetSuspended(suspend bool) {
+	obj.Spec.Suspend = suspend
+}
+
+func (obj ImageRepositoryAdapter) DeepCopyClientObject() client.Object {
+	return obj.DeepCopy()
+}
+
+// ImagePolicyAdapter is an Automation whose source is the ImageRepository it
+// selects images from. ImagePolicies can't be suspended.
+type ImagePolicyAdapter struct {
+	*reflectorv1.ImagePolicy
+}
+
+func (obj ImagePolicyAdapter) GetConditions() []metav1.Condition {
+	return obj.Status.Conditions
+}
+
+func (obj ImagePolicyAdapter) SetConditions(conditions []metav1.Condition) {
+	obj.Status.Conditions = conditions
+}
+
+func (obj ImagePolicyAdapter) GetLastHandledReconcileRequest() string {
+	return ""
+}
+
+func (obj ImagePolicyAdapter) AsClientObject() client.Object {
+	return obj.ImagePolicy
+}
+
+func (obj ImagePolicyAdapter) SourceRef() SourceRef {
+	return sRef{
+		apiVersion: reflectorv1.GroupVersion.String(),
+		name:       obj.Spec.ImageRepositoryRef.Name,
+		namespace:  obj.Spec.ImageRepositoryRef.Namespace,
+		kind:       reflectorv1.ImageRepositoryKind,
+	}
+}
+
+func (obj ImagePolicyAdapter) GroupVersionKind() schema.GroupVersionKind {
+	return reflectorv1.GroupVersion.WithKind(reflectorv1.ImagePolicyKind)
+}
+
+func (obj ImagePolicyAdapter) SetSuspended(suspend bool) {}
+
+func (obj ImagePolicyAdapter) DeepCopyClientObject() client.Object {
+	return obj.DeepCopy()
+}
+
+func (obj ImagePolicyAdapter) tracksReconcileRequests() bool {
+	return false
+}
+
+func (obj ImagePolicyAdapter) observedGeneration() int64 {
+	return obj.Status.ObservedGeneration
+}
+
+func (obj ImagePolicyAdapter) suspendable() bool {
+	return false
+}
+
+type ImageUpdateAutomationAdapter struct {
+	*imageautomationv1.ImageUpdateAutomation
+}
+
+func (obj ImageUpdateAutomationAdapter) GetLastHandledReconcileRequest() string {
+	return obj.Status.GetLastHandledReconcileRequest()
+}
+
+func (obj ImageUpdateAutomationAdapter) AsClientObject() client.Object {
+	return obj.ImageUpdateAutomation
+}
+
+func (obj ImageUpdateAutomationAdapter) SourceRef() SourceRef {
+	return sRef{
+		apiVersion: obj.Spec.SourceRef.APIVersion,
+		name:       obj.Spec.SourceRef.Name,
+		namespace:  obj.Spec.SourceRef.Namespace,
+		kind:       obj.Spec.SourceRef.Kind,
+	}
+}
+
+func (obj ImageUpdateAutomationAdapter) GroupVersionKind() schema.GroupVersionKind {
+	return imageautomationv1.GroupVersion.WithKind(imageautomationv1.ImageUpdateAutomationKind)
+}
+
+func (obj ImageUpdateAutomationAdapter) SetSuspended(suspend bool) {
+	obj.Spec.Suspend = suspend
+}
+
+func (obj ImageUpdateAutomationAdapter) DeepCopyClientObject() client.Object {
+	return obj.DeepCopy()
+}
+
+type ProviderAdapter struct {
+	*notificationv1.Provider
+}
+
+func (obj ProviderAdapter) GetLastHandledReconcileRequest() string {
+	return ""
+}
+
+func (obj ProviderAdapter) AsClientObject() client.Object {
+	return obj.Provider
+}
+
+func (obj ProviderAdapter) GroupVersionKind() schema.GroupVersionKind {
+	return notificationv1.GroupVersion.WithKind(notificationv1.ProviderKind)
+}
+
+func (obj ProviderAdapter) SetSuspended(suspend bool) {
+	obj.Spec.Suspend = suspend
+}
+
+func (obj ProviderAdapter) DeepCopyClientObject() client.Object {
+	return obj.DeepCopy()
+}
+
+func (obj ProviderAdapter) tracksReconcileRequests() bool {
+	return false
+}
+
+func (obj ProviderAdapter) observedGeneration() int64 {
+	return obj.Status.ObservedGeneration
+}
+
+type AlertAdapter struct {
+	*notificationv1.Alert
+}
+
+func (obj AlertAdapter) GetLastHandledReconcileRequest() string {
+	return ""
+}
+
+func (obj AlertAdapter) AsClientObject() client.Object {
+	return obj.Alert
+}
+
+func (obj AlertAdapter) GroupVersionKind() schema.GroupVersionKind {
+	return notificationv1.GroupVersion.WithKind(notificationv1.AlertKind)
+}
+
+func (obj AlertAdapter) SetSuspended(suspend bool) {
+	obj.Spec.Suspend = suspend
+}
+
+func (obj AlertAdapter) DeepCopyClientObject() client.Object {
+	return obj.DeepCopy()
+}
+
+func (obj AlertAdapter) tracksReconcileRequests() bool {
+	return false
+}
+
+func (obj AlertAdapter) observedGeneration() int64 {
+	return obj.Status.ObservedGeneration
+}
+
+// reconcileRequestTracker is implemented by adapters for kinds whose status
+// doesn't record the last reconcile request handled. Like the flux CLI does,
+// syncing these waits for the controller to have observed the latest
+// generation and for them to be ready.
+type reconcileRequestTracker interface {
+	tracksReconcileRequests() bool
+}
+
+func tracksReconcileRequests(obj Reconcilable) bool {
+	if t, ok := obj.(reconcileRequestTracker); ok {
+		return t.tracksReconcileRequests()
+	}
+
+	return true
+}
+
+type generationObserver interface {
+	observedGeneration() int64
+}
+
+// hasObservedGeneration returns true once the controller has reconciled the
+// current generation of the object, so its Ready condition is up to date.
+func hasObservedGeneration(obj Reconcilable) bool {
+	if o, ok := obj.(generationObserver); ok {
+		return o.observedGeneration() >= obj.GetGeneration()
+	}
+
+	return true
+}
+
+type suspender interface {
+	suspendable() bool
+}
+
+// IsSuspendable returns false for kinds that have no spec.suspend field,
+// where SetSuspended does nothing.
+func IsSuspendable(obj Reconcilable) bool {
+	if s, ok := obj.(suspender); ok {
+		return s.suspendable()
+	}
+
+	return true
+}
+
 type sRef struct {
 	apiVersion string
 	name       string
@@ -290,6 +530,21 @@ func ToReconcileable(kind string) (client.ObjectList, Reconcilable, error) {
 
 	case sourcev1.OCIRepositoryKind:
 		return &sourcev1.OCIRepositoryList{}, NewReconcileable(&sourcev1.OCIRepository{}), nil
+
+	case reflectorv1.ImageRepositoryKind:
+		return &reflectorv1.ImageRepositoryList{}, NewReconcileable(&reflectorv1.ImageRepository{}), nil
+
+	case reflectorv1.ImagePolicyKind:
+		return &reflectorv1.ImagePolicyList{}, NewReconcileable(&reflectorv1.ImagePolicy{}), nil
+
+	case imageautomationv1.ImageUpdateAutomationKind:
+		return &imageautomationv1.ImageUpdateAutomationList{}, NewReconcileable(&imageautomationv1.ImageUpdateAutomation{}), nil
+
+	case notificationv1.ProviderKind:
+		return &notificationv1.ProviderList{}, NewReconcileable(&notificationv1.Provider{}), nil
+
+	case notificationv1.AlertKind:
+		return &notificationv1.AlertList{}, NewReconcileable(&notificationv1.Alert{}), nil
 	}
 
 	return nil, nil, errors.New("could not find source type")
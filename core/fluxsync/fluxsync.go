@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/fluxcd/pkg/apis/meta"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/wait"
@@ -66,6 +67,10 @@ func checkResourceSync(ctx context.Context, c client.Client, name client.ObjectK
 			return false, err
 		}
 
+		if !tracksReconcileRequests(obj) {
+			return hasObservedGeneration(obj) && apimeta.IsStatusConditionTrue(obj.GetConditions(), meta.ReadyCondition), nil
+		}
+
 		return obj.GetLastHandledReconcileRequest() != lastReconcile, nil
 	}
 }
@@ -3,6 +3,7 @@ package nsaccess
 import (
 	"context"
 	"fmt"
+	"sort"
 
 	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -10,6 +11,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	typedauth "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"sigs.k8s.io/yaml"
 )
 
 //go:generate go run github.com/maxbrunsfeld/counterfeiter/v6 -generate
@@ -48,6 +50,76 @@ var DefautltWegoAppRules = []rbacv1.PolicyRule{
 	},
 }
 
+// RulesConfigMapKey is the key in a ConfigMap that holds the Rules as YAML.
+const RulesConfigMapKey = "rules"
+
+// Rules are the permissions a user needs to use a namespace.
+type Rules struct {
+	// Required are the permissions a user needs to see the namespace at all.
+	Required []rbacv1.PolicyRule `json:"required,omitempty"`
+	// Kinds are the permissions a user needs to see objects of each kind.
+	// When set, a namespace is only accessible if the user can see at least
+	// one kind there.
+	Kinds map[string][]rbacv1.PolicyRule `json:"kinds,omitempty"`
+}
+
+// ParseRules reads Rules from YAML, e.g.
+//
+//	required:
+//	- apiGroups: [""]
+//	  resources: ["events"]
+//	  verbs: ["get", "list"]
+//	kinds:
+//	  Kustomization:
+//	  - apiGroups: ["kustomize.toolkit.fluxcd.io"]
+//	    resources: ["kustomizations"]
+//	    verbs: ["get", "list"]
+func ParseRules(data []byte) (Rules, error) {
+	rules := Rules{}
+
+	if err := yaml.UnmarshalStrict(data, &rules); err != nil {
+		return Rules{}, fmt.Errorf("parsing namespace access rules: %w", err)
+	}
+
+	for _, rule := range rules.Required {
+		if err := validateRule(rule); err != nil {
+			return Rules{}, fmt.Errorf("invalid required rule: %w", err)
+		}
+	}
+
+	for kind, kindRules := range rules.Kinds {
+		if len(kindRules) == 0 {
+			return Rules{}, fmt.Errorf("invalid rules for kind %s: no rules", kind)
+		}
+
+		for _, rule := range kindRules {
+			if err := validateRule(rule); err != nil {
+				return Rules{}, fmt.Errorf("invalid rules for kind %s: %w", kind, err)
+			}
+		}
+	}
+
+	return rules, nil
+}
+
+// RulesFromConfigMap reads Rules from the RulesConfigMapKey of a ConfigMap.
+func RulesFromConfigMap(cm *corev1.ConfigMap) (Rules, error) {
+	data, ok := cm.Data[RulesConfigMapKey]
+	if !ok {
+		return Rules{}, fmt.Errorf("configmap %s/%s has no %q key", cm.Namespace, cm.Name, RulesConfigMapKey)
+	}
+
+	return ParseRules([]byte(data))
+}
+
+func validateRule(rule rbacv1.PolicyRule) error {
+	if len(rule.APIGroups) == 0 || len(rule.Resources) == 0 || len(rule.Verbs) == 0 {
+		return fmt.Errorf("apiGroups, resources and verbs must all be set")
+	}
+
+	return nil
+}
+
 // Checker contains methods for validing user access to Kubernetes namespaces, based on a set of PolicyRules
 //
 //counterfeiter:generate . Checker
@@ -56,32 +128,83 @@ type Checker interface {
 	FilterAccessibleNamespaces(ctx context.Context, auth typedauth.AuthorizationV1Interface, namespaces []corev1.Namespace) ([]corev1.Namespace, error)
 }
 
+// KindsChecker is a Checker that can also tell which kinds of object a user
+// can read in each of the namespaces they have access to.
+type KindsChecker interface {
+	Checker
+	// FilterAccessibleNamespacesAndKinds returns the namespaces a user has
+	// access to, and the kinds they can read in each of them, keyed by
+	// namespace name. The kinds are nil if there are no kind rules, in which
+	// case the user can read all kinds.
+	FilterAccessibleNamespacesAndKinds(ctx context.Context, auth typedauth.AuthorizationV1Interface, namespaces []corev1.Namespace) ([]corev1.Namespace, map[string][]string, error)
+}
+
 type simpleChecker struct {
-	rules []rbacv1.PolicyRule
+	rules Rules
 }
 
+// NewChecker returns a Checker that requires all of the rules in a namespace.
 func NewChecker(rules []rbacv1.PolicyRule) Checker {
+	return simpleChecker{rules: Rules{Required: rules}}
+}
+
+// NewRulesChecker returns a KindsChecker that requires the required rules in
+// a namespace, and works out which kinds the user can read there.
+func NewRulesChecker(rules Rules) KindsChecker {
 	return simpleChecker{rules: rules}
 }
 
 func (sc simpleChecker) FilterAccessibleNamespaces(ctx context.Context, auth typedauth.AuthorizationV1Interface, namespaces []corev1.Namespace) ([]corev1.Namespace, error) {
+	result, _, err := sc.FilterAccessibleNamespacesAndKinds(ctx, auth, namespaces)
+
+	return result, err
+}
+
+func (sc simpleChecker) FilterAccessibleNamespacesAndKinds(ctx context.Context, auth typedauth.AuthorizationV1Interface, namespaces []corev1.Namespace) ([]corev1.Namespace, map[string][]string, error) {
 	result := []corev1.Namespace{}
 
+	var accessibleKinds map[string][]string
+	if len(sc.rules.Kinds) > 0 {
+		accessibleKinds = map[string][]string{}
+	}
+
 	for _, ns := range namespaces {
-		ok, err := userCanUseNamespace(ctx, auth, ns, sc.rules)
+		status, err := userRules(ctx, auth, ns)
 		if err != nil {
-			return nil, fmt.Errorf("user namespace access: %w", err)
+			return nil, nil, fmt.Errorf("user namespace access: %w", err)
 		}
 
-		if ok {
+		if !hasAllRules(status, sc.rules.Required, ns.Name) {
+			continue
+		}
+
+		if len(sc.rules.Kinds) == 0 {
 			result = append(result, ns)
+			continue
+		}
+
+		kinds := []string{}
+
+		for kind, rules := range sc.rules.Kinds {
+			if hasAllRules(status, rules, ns.Name) {
+				kinds = append(kinds, kind)
+			}
+		}
+
+		if len(kinds) == 0 {
+			continue
 		}
+
+		sort.Strings(kinds)
+
+		result = append(result, ns)
+		accessibleKinds[ns.Name] = kinds
 	}
 
-	return result, nil
+	return result, accessibleKinds, nil
 }
 
-func userCanUseNamespace(ctx context.Context, auth typedauth.AuthorizationV1Interface, ns corev1.Namespace, rules []rbacv1.PolicyRule) (bool, error) {
+func userRules(ctx context.Context, auth typedauth.AuthorizationV1Interface, ns corev1.Namespace) (authorizationv1.SubjectRulesReviewStatus, error) {
 	sar := &authorizationv1.SelfSubjectRulesReview{
 		Spec: authorizationv1.SelfSubjectRulesReviewSpec{
 			Namespace: ns.Name,
@@ -90,10 +213,10 @@ func userCanUseNamespace(ctx context.Context, auth typedauth.AuthorizationV1Inte
 
 	authRes, err := auth.SelfSubjectRulesReviews().Create(ctx, sar, metav1.CreateOptions{})
 	if err != nil {
-		return false, err
+		return authorizationv1.SubjectRulesReviewStatus{}, err
 	}
 
-	return hasAllRules(authRes.Status, rules, ns.Name), nil
+	return authRes.Status, nil
 }
 
 var allK8sVerbs = []string{"create", "get", "list", "watch", "patch", "delete", "deletecollection"}
@@ -0,0 +1,121 @@
+package nsaccess
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+const testRules = `
+required:
+- apiGroups: [""]
+  resources: ["events"]
+  verbs: ["get", "list"]
+kinds:
+  Kustomization:
+  - apiGroups: ["kustomize.toolkit.fluxcd.io"]
+    resources: ["kustomizations"]
+    verbs: ["get", "list"]
+  Secret:
+  - apiGroups: [""]
+    resources: ["secrets"]
+    verbs: ["get", "list"]
+`
+
+func TestParseRules(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rules, err := ParseRules([]byte(testRules))
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rules.Required).To(HaveLen(1))
+	g.Expect(rules.Kinds).To(HaveKey("Kustomization"))
+	g.Expect(rules.Kinds).To(HaveKey("Secret"))
+
+	_, err = ParseRules([]byte("kinds:\n  Secret: []\n"))
+	g.Expect(err).To(MatchError(ContainSubstring("no rules")))
+
+	_, err = ParseRules([]byte("required:\n- resources: [secrets]\n  verbs: [get]\n"))
+	g.Expect(err).To(MatchError(ContainSubstring("must all be set")))
+
+	_, err = ParseRules([]byte("requried: []\n"))
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestRulesFromConfigMap(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	cm := &corev1.ConfigMap{}
+	cm.Namespace = "flux-system"
+	cm.Name = "namespace-access"
+
+	_, err := RulesFromConfigMap(cm)
+	g.Expect(err).To(MatchError(`configmap flux-system/namespace-access has no "rules" key`))
+
+	cm.Data = map[string]string{RulesConfigMapKey: testRules}
+
+	rules, err := RulesFromConfigMap(cm)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(rules.Kinds).To(HaveKey("Secret"))
+}
+
+func TestRulesCheckerRecordsKinds(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	rules, err := ParseRules([]byte(testRules))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	events := authorizationv1.ResourceRule{APIGroups: []string{""}, Resources: []string{"events"}, Verbs: []string{"get", "list"}}
+	kustomizations := authorizationv1.ResourceRule{APIGroups: []string{"kustomize.toolkit.fluxcd.io"}, Resources: []string{"kustomizations"}, Verbs: []string{"get", "list"}}
+
+	userRules := map[string][]authorizationv1.ResourceRule{
+		// Everything, the user is an admin here.
+		"admin": {{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}},
+		// Flux objects, but no secrets.
+		"apps": {events, kustomizations},
+		// Events, but nothing the user can list.
+		"events-only": {events},
+		// Kustomizations, but not the required events.
+		"no-events": {kustomizations},
+	}
+
+	clientset := fake.NewSimpleClientset()
+	clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+		review.Status.ResourceRules = userRules[review.Spec.Namespace]
+
+		return true, review, nil
+	})
+
+	namespaces := []corev1.Namespace{}
+
+	for _, name := range []string{"admin", "apps", "events-only", "no-events"} {
+		ns := corev1.Namespace{}
+		ns.Name = name
+		namespaces = append(namespaces, ns)
+	}
+
+	result, kinds, err := NewRulesChecker(rules).FilterAccessibleNamespacesAndKinds(context.Background(), clientset.AuthorizationV1(), namespaces)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(HaveLen(2))
+	g.Expect(result[0].Name).To(Equal("admin"))
+	g.Expect(result[1].Name).To(Equal("apps"))
+
+	g.Expect(kinds).To(Equal(map[string][]string{
+		"admin": {"Kustomization", "Secret"},
+		"apps":  {"Kustomization"},
+	}))
+
+	// The namespaces aren't changed.
+	g.Expect(result[0].Annotations).To(BeNil())
+
+	result, kinds, err = NewRulesChecker(Rules{Required: rules.Kinds["Kustomization"]}).FilterAccessibleNamespacesAndKinds(context.Background(), clientset.AuthorizationV1(), namespaces)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(result).To(HaveLen(3))
+	g.Expect(kinds).To(BeNil())
+}
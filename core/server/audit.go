@@ -0,0 +1,39 @@
+package server
+
+import (
+	"context"
+
+	"github.com/weaveworks/weave-gitops/core/audit"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+// recordAudit records the outcome of a mutating action on obj. Failing to
+// record is logged but doesn't fail the request.
+func (cs *coreServer) recordAudit(ctx context.Context, action audit.Action, principal *auth.UserPrincipal, obj *pb.ObjectRef, err error) {
+	var (
+		id     string
+		groups []string
+	)
+
+	if principal != nil {
+		id = principal.ID
+		groups = principal.Groups
+	}
+
+	event := audit.NewEvent(action, id, groups, err)
+	event.Cluster = obj.ClusterName
+	event.Object = &audit.ObjectRef{
+		Kind:      obj.Kind,
+		Name:      obj.Name,
+		Namespace: obj.Namespace,
+	}
+
+	if gvk, err := cs.primaryKinds.Lookup(obj.Kind); err == nil {
+		event.Object.APIVersion = gvk.GroupVersion().String()
+	}
+
+	if err := cs.auditSink.Record(ctx, event); err != nil {
+		cs.logger.Error(err, "failed recording audit event", "action", action, "user", id)
+	}
+}
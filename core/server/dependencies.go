@@ -0,0 +1,327 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	apimeta "k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/cli-utils/pkg/object"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// dependencyGraphKinds are the kinds that make up a dependency graph, in the
+// order they're listed in. Anything else in a Kustomization's inventory is left out.
+var dependencyGraphKinds = []string{
+	kustomizev1.KustomizationKind,
+	helmv2.HelmReleaseKind,
+	sourcev1.GitRepositoryKind,
+	sourcev1.OCIRepositoryKind,
+	sourcev1.BucketKind,
+	sourcev1.HelmRepositoryKind,
+}
+
+func (cs *coreServer) GetDependencyGraph(ctx context.Context, msg *pb.GetDependencyGraphRequest) (*pb.GetDependencyGraphResponse, error) {
+	if msg.ClusterName == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "bad request: no cluster name was specified")
+	}
+
+	principal := auth.Principal(ctx)
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	g := &dependencyGraph{
+		cs:          cs,
+		client:      clustersClient,
+		clusterName: msg.ClusterName,
+		objects:     map[string]*unstructured.Unstructured{},
+		nodes:       map[string]*pb.DependencyNode{},
+		res:         &pb.GetDependencyGraphResponse{},
+	}
+
+	var start []*pb.ObjectRef
+
+	if msg.Root != nil {
+		if !isDependencyGraphKind(msg.Root.Kind) {
+			return nil, status.Errorf(codes.InvalidArgument, "dependency graphs can't start from kind %q", msg.Root.Kind)
+		}
+
+		start = []*pb.ObjectRef{{
+			Kind:        msg.Root.Kind,
+			Name:        msg.Root.Name,
+			Namespace:   msg.Root.Namespace,
+			ClusterName: msg.ClusterName,
+		}}
+	} else {
+		namespaces := watchNamespaces(msg.Namespace, cs.clustersManager.GetUserNamespaces(principal)[msg.ClusterName])
+
+		start, err = g.list(ctx, namespaces)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for _, ref := range start {
+		if err := g.visit(ctx, ref); err != nil {
+			return nil, err
+		}
+	}
+
+	return g.res, nil
+}
+
+type dependencyGraph struct {
+	cs          *coreServer
+	client      clustersmngr.Client
+	clusterName string
+	// objects already fetched by listing, to save getting them one by one
+	objects map[string]*unstructured.Unstructured
+	nodes   map[string]*pb.DependencyNode
+	res     *pb.GetDependencyGraphResponse
+}
+
+// list fetches every graph object in namespaces, returning references to
+// them. Namespaces that can't be listed are reported as errors in the
+// response, so the graph is still made of the others.
+func (g *dependencyGraph) list(ctx context.Context, namespaces []string) ([]*pb.ObjectRef, error) {
+	refs := []*pb.ObjectRef{}
+
+	for _, kind := range dependencyGraphKinds {
+		gvk, err := g.cs.primaryKinds.Lookup(kind)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, ns := range namespaces {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(*gvk)
+
+			if err := g.client.List(ctx, g.clusterName, list, client.InNamespace(ns)); err != nil {
+				if k8serrors.IsForbidden(err) || apimeta.IsNoMatchError(err) {
+					continue
+				}
+
+				g.addError(ns, fmt.Errorf("listing %s: %w", kind, err))
+
+				continue
+			}
+
+			for i := range list.Items {
+				obj := &list.Items[i]
+				ref := g.ref(kind, obj.GetName(), obj.GetNamespace())
+
+				g.objects[dependencyNodeKey(ref)] = obj
+				refs = append(refs, ref)
+			}
+		}
+	}
+
+	return refs, nil
+}
+
+func (g *dependencyGraph) addError(namespace string, err error) {
+	g.res.Errors = append(g.res.Errors, &pb.ListError{
+		ClusterName: g.clusterName,
+		Namespace:   namespace,
+		Message:     err.Error(),
+	})
+}
+
+// visit adds the node for ref, and everything it depends on, to the graph.
+func (g *dependencyGraph) visit(ctx context.Context, ref *pb.ObjectRef) error {
+	key := dependencyNodeKey(ref)
+	if _, ok := g.nodes[key]; ok {
+		return nil
+	}
+
+	node := &pb.DependencyNode{Object: ref}
+	g.nodes[key] = node
+	g.res.Nodes = append(g.res.Nodes, node)
+
+	obj, err := g.get(ctx, ref)
+	if err != nil {
+		// What depends on the object is still in the graph, only its own
+		// dependencies are left out.
+		g.addError(ref.Namespace, err)
+		return nil
+	}
+
+	if obj == nil {
+		node.Missing = true
+		return nil
+	}
+
+	node.Suspended, _, _ = unstructured.NestedBool(obj.Object, "spec", "suspend")
+	node.Ready = readyCondition(obj)
+
+	edges, err := g.edges(ref, obj)
+	if err != nil {
+		return fmt.Errorf("finding dependencies of %s %s/%s: %w", ref.Kind, ref.Namespace, ref.Name, err)
+	}
+
+	for _, edge := range edges {
+		g.res.Edges = append(g.res.Edges, edge)
+
+		if err := g.visit(ctx, edge.To); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// get returns the object ref points to, or nil if it doesn't exist or the
+// user isn't allowed to see it.
+func (g *dependencyGraph) get(ctx context.Context, ref *pb.ObjectRef) (*unstructured.Unstructured, error) {
+	if obj, ok := g.objects[dependencyNodeKey(ref)]; ok {
+		return obj, nil
+	}
+
+	gvk, err := g.cs.primaryKinds.Lookup(ref.Kind)
+	if err != nil {
+		return nil, nil
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(*gvk)
+
+	key := client.ObjectKey{Name: ref.Name, Namespace: ref.Namespace}
+	if err := g.client.Get(ctx, g.clusterName, key, obj); err != nil {
+		if k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) || apimeta.IsNoMatchError(err) {
+			return nil, nil
+		}
+
+		return nil, fmt.Errorf("getting %s %s/%s: %w", ref.Kind, ref.Namespace, ref.Name, err)
+	}
+
+	return obj, nil
+}
+
+func (g *dependencyGraph) edges(from *pb.ObjectRef, obj *unstructured.Unstructured) ([]*pb.DependencyEdge, error) {
+	edges := []*pb.DependencyEdge{}
+
+	addEdge := func(kind, name, namespace string, depType pb.DependencyType) {
+		// References default to the namespace of the object holding them
+		if namespace == "" {
+			namespace = from.Namespace
+		}
+
+		edges = append(edges, &pb.DependencyEdge{
+			From: from,
+			To:   g.ref(kind, name, namespace),
+			Type: depType,
+		})
+	}
+
+	switch from.Kind {
+	case kustomizev1.KustomizationKind:
+		kustomization := &kustomizev1.Kustomization{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, kustomization); err != nil {
+			return nil, err
+		}
+
+		for _, dep := range kustomization.Spec.DependsOn {
+			addEdge(kustomizev1.KustomizationKind, dep.Name, dep.Namespace, pb.DependencyType_DependsOn)
+		}
+
+		sourceRef := kustomization.Spec.SourceRef
+		addEdge(sourceRef.Kind, sourceRef.Name, sourceRef.Namespace, pb.DependencyType_SourceRef)
+
+		if kustomization.Status.Inventory != nil {
+			for _, entry := range kustomization.Status.Inventory.Entries {
+				objMeta, err := object.ParseObjMetadata(entry.ID)
+				if err != nil {
+					return nil, fmt.Errorf("parsing inventory entry %q: %w", entry.ID, err)
+				}
+
+				if isDependencyGraphKind(objMeta.GroupKind.Kind) {
+					addEdge(objMeta.GroupKind.Kind, objMeta.Name, objMeta.Namespace, pb.DependencyType_Inventory)
+				}
+			}
+		}
+	case helmv2.HelmReleaseKind:
+		helmRelease := &helmv2.HelmRelease{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, helmRelease); err != nil {
+			return nil, err
+		}
+
+		for _, dep := range helmRelease.Spec.DependsOn {
+			addEdge(helmv2.HelmReleaseKind, dep.Name, dep.Namespace, pb.DependencyType_DependsOn)
+		}
+
+		sourceRef := helmRelease.Spec.Chart.Spec.SourceRef
+		addEdge(sourceRef.Kind, sourceRef.Name, sourceRef.Namespace, pb.DependencyType_ChartSourceRef)
+	}
+
+	return edges, nil
+}
+
+func (g *dependencyGraph) ref(kind, name, namespace string) *pb.ObjectRef {
+	return &pb.ObjectRef{
+		Kind:        kind,
+		Name:        name,
+		Namespace:   namespace,
+		ClusterName: g.clusterName,
+	}
+}
+
+func dependencyNodeKey(ref *pb.ObjectRef) string {
+	return ref.Kind + "/" + ref.Namespace + "/" + ref.Name
+}
+
+func isDependencyGraphKind(kind string) bool {
+	for _, k := range dependencyGraphKinds {
+		if k == kind {
+			return true
+		}
+	}
+
+	return false
+}
+
+func readyCondition(obj *unstructured.Unstructured) *pb.Condition {
+	rawConditions, found, err := unstructured.NestedSlice(obj.Object, "status", "conditions")
+	if err != nil || !found {
+		return nil
+	}
+
+	for _, raw := range rawConditions {
+		rawMap, ok := raw.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		condition := metav1.Condition{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(rawMap, &condition); err != nil {
+			continue
+		}
+
+		if condition.Type == meta.ReadyCondition {
+			return &pb.Condition{
+				Type:      condition.Type,
+				Status:    string(condition.Status),
+				Reason:    condition.Reason,
+				Message:   condition.Message,
+				Timestamp: condition.LastTransitionTime.Format(time.RFC3339),
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,177 @@
+package server_test
+
+import (
+	"context"
+	"testing"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetDependencyGraph(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	notReady := []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionFalse, Reason: "DependencyNotReady"}}
+	ready := []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Reason: meta.SucceededReason}}
+
+	apps := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "apps", Namespace: ns.Name},
+		Spec: kustomizev1.KustomizationSpec{
+			DependsOn: []meta.NamespacedObjectReference{{Name: "infra"}},
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "repo"},
+		},
+		Status: kustomizev1.KustomizationStatus{
+			Conditions: notReady,
+			Inventory: &kustomizev1.ResourceInventory{
+				Entries: []kustomizev1.ResourceRef{
+					{ID: ns.Name + "_podinfo_helm.toolkit.fluxcd.io_HelmRelease", Version: "v2beta1"},
+					{ID: ns.Name + "_podinfo_apps_Deployment", Version: "v1"},
+				},
+			},
+		},
+	}
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "repo", Namespace: ns.Name},
+		Status:     sourcev1.GitRepositoryStatus{Conditions: ready},
+	}
+	podinfo := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: ns.Name},
+		Spec: helmv2.HelmReleaseSpec{
+			Suspend: true,
+			Chart: helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:     "podinfo",
+					SourceRef: helmv2.CrossNamespaceObjectReference{Kind: sourcev1.HelmRepositoryKind, Name: "charts", Namespace: "flux-system"},
+				},
+			},
+		},
+	}
+	unrelated := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: ns.Name},
+		Spec: kustomizev1.KustomizationSpec{
+			SourceRef: kustomizev1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "repo"},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, apps, repo, podinfo, unrelated).Build()
+	c := makeServer(makeServerConfig(fakeClient, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	res, err := c.GetDependencyGraph(outgoingCtx, &pb.GetDependencyGraphRequest{
+		ClusterName: "Default",
+		Root:        &pb.ObjectRef{Kind: kustomizev1.KustomizationKind, Name: "apps", Namespace: ns.Name},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	nodes := map[string]*pb.DependencyNode{}
+	for _, n := range res.Nodes {
+		nodes[n.Object.Kind+"/"+n.Object.Namespace+"/"+n.Object.Name] = n
+	}
+
+	g.Expect(nodes).To(HaveLen(5))
+	g.Expect(nodes).NotTo(HaveKey("Kustomization/test-namespace/unrelated"))
+
+	g.Expect(nodes["Kustomization/test-namespace/apps"].Ready.Status).To(Equal("False"))
+	g.Expect(nodes["Kustomization/test-namespace/infra"].Missing).To(BeTrue())
+	g.Expect(nodes["GitRepository/test-namespace/repo"].Ready.Status).To(Equal("True"))
+	g.Expect(nodes["HelmRelease/test-namespace/podinfo"].Suspended).To(BeTrue())
+	g.Expect(nodes["HelmRelease/test-namespace/podinfo"].Ready).To(BeNil())
+	g.Expect(nodes["HelmRepository/flux-system/charts"].Missing).To(BeTrue())
+
+	edges := map[string]pb.DependencyType{}
+	for _, e := range res.Edges {
+		edges[e.From.Name+"->"+e.To.Name] = e.Type
+	}
+
+	g.Expect(edges).To(Equal(map[string]pb.DependencyType{
+		"apps->infra":     pb.DependencyType_DependsOn,
+		"apps->repo":      pb.DependencyType_SourceRef,
+		"apps->podinfo":   pb.DependencyType_Inventory,
+		"podinfo->charts": pb.DependencyType_ChartSourceRef,
+	}))
+
+	res, err = c.GetDependencyGraph(outgoingCtx, &pb.GetDependencyGraphRequest{
+		ClusterName: "Default",
+		Namespace:   ns.Name,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Nodes).To(HaveLen(6))
+}
+
+func TestGetDependencyGraphReportsNamespacesThatCantBeListed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+	broken := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "broken"}}
+
+	repo := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "repo", Namespace: ns.Name},
+	}
+	hidden := &sourcev1.GitRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "hidden", Namespace: broken.Name},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, broken, repo, hidden).Build()
+	c := makeServer(makeServerConfig(failingNamespaceClient{Client: fakeClient, namespace: broken.Name}, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	res, err := c.GetDependencyGraph(outgoingCtx, &pb.GetDependencyGraphRequest{
+		ClusterName: "Default",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(res.Nodes).To(HaveLen(1))
+	g.Expect(res.Nodes[0].Object.Name).To(Equal("repo"))
+
+	g.Expect(res.Errors).NotTo(BeEmpty())
+	g.Expect(res.Errors[0].Message).To(HavePrefix("listing "))
+
+	for _, listErr := range res.Errors {
+		g.Expect(listErr.ClusterName).To(Equal("Default"))
+		g.Expect(listErr.Namespace).To(Equal(broken.Name))
+		g.Expect(listErr.Message).To(ContainSubstring("timed out"))
+	}
+}
+
+func TestGetDependencyGraphWithoutClusterName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).Build()
+	c := makeServer(makeServerConfig(fakeClient, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(context.Background(), md)
+
+	_, err = c.GetDependencyGraph(outgoingCtx, &pb.GetDependencyGraphRequest{Namespace: "flux-system"})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+}
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
-	"os"
 	"sync"
 
 	"github.com/hashicorp/go-multierror"
@@ -28,7 +27,7 @@ import (
 )
 
 const (
-	FluxNamespacePartOf = "flux"
+	FluxNamespacePartOf = coretypes.FluxNamespacePartOf
 )
 
 var (
@@ -42,17 +41,9 @@ var (
 	// ErrListingDeployments no deployments found
 	ErrListingDeployments = errors.New("could not list deployments in namespace")
 
-	DefaultFluxNamespace = lookupEnv("WEAVE_GITOPS_FALLBACK_NAMESPACE", "flux-system")
+	DefaultFluxNamespace = coretypes.DefaultFluxNamespace
 )
 
-func lookupEnv(envVar string, fallback string) string {
-	if val, ok := os.LookupEnv(envVar); ok {
-		return val
-	}
-
-	return fallback
-}
-
 func (cs *coreServer) ListFluxRuntimeObjects(ctx context.Context, msg *pb.ListFluxRuntimeObjectsRequest) (*pb.ListFluxRuntimeObjectsResponse, error) {
 	respErrors := []*pb.ListError{}
 
@@ -67,10 +58,22 @@ func (cs *coreServer) ListFluxRuntimeObjects(ctx context.Context, msg *pb.ListFl
 		}
 	}
 
+	pageToken := msg.GetPagination().GetPageToken()
+	paginationInfo := &clustersmngr.PaginationInfo{}
+
+	if pageToken != "" {
+		paginationInfo, err = clustersmngr.DecodePaginationInfo(pageToken)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	nextPaginationInfo := &clustersmngr.PaginationInfo{}
+
 	var results []*pb.Deployment
 
 	for clusterName, nss := range cs.clustersManager.GetClustersNamespaces() {
-		fluxNamepsaces := filterFluxNamespace(nss)
+		fluxNamepsaces := coretypes.FilterFluxNamespaces(nss)
 		if len(fluxNamepsaces) == 0 {
 			respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Namespace: "", Message: ErrFluxNamespaceNotFound.Error()})
 			continue
@@ -83,11 +86,28 @@ func (cs *coreServer) ListFluxRuntimeObjects(ctx context.Context, msg *pb.ListFl
 		list := &appsv1.DeploymentList{}
 
 		for _, fluxNs := range fluxNamepsaces {
-			if err := clustersClient.List(ctx, clusterName, list, opts, client.InNamespace(fluxNs.Name)); err != nil {
+			nsContinueToken := paginationInfo.Get(clusterName, fluxNs.Name)
+
+			// this namespace has been fully listed by a previous page
+			if pageToken != "" && nsContinueToken == "" {
+				continue
+			}
+
+			listOpts := []client.ListOption{opts, client.InNamespace(fluxNs.Name), paginationInfo.Continue(clusterName, fluxNs.Name)}
+			if pageSize := msg.GetPagination().GetPageSize(); pageSize > 0 {
+				listOpts = append(listOpts, client.Limit(int64(pageSize)))
+			}
+
+			if err := clustersClient.List(ctx, clusterName, list, listOpts...); err != nil {
 				respErrors = append(respErrors, &pb.ListError{ClusterName: clusterName, Namespace: fluxNs.Name, Message: fmt.Sprintf("%s, %s", ErrListingDeployments.Error(), err)})
+				// Try the namespace again on the next page.
+				nextPaginationInfo.SetFailed(clusterName, fluxNs.Name, nsContinueToken)
+
 				continue
 			}
 
+			nextPaginationInfo.Set(clusterName, fluxNs.Name, list.GetContinue())
+
 			for _, d := range list.Items {
 				r := &pb.Deployment{
 					Name:        d.Name,
@@ -116,7 +136,16 @@ func (cs *coreServer) ListFluxRuntimeObjects(ctx context.Context, msg *pb.ListFl
 		}
 	}
 
-	return &pb.ListFluxRuntimeObjectsResponse{Deployments: results, Errors: respErrors}, nil
+	nextToken := ""
+
+	if nextPaginationInfo.HasMore() {
+		nextToken, err = clustersmngr.EncodePaginationInfo(nextPaginationInfo)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.ListFluxRuntimeObjectsResponse{Deployments: results, Errors: respErrors, NextToken: nextToken}, nil
 }
 
 func (cs *coreServer) ListFluxCrds(ctx context.Context, msg *pb.ListFluxCrdsRequest) (*pb.ListFluxCrdsResponse, error) {
@@ -184,29 +213,85 @@ func (cs *coreServer) ListFluxCrds(ctx context.Context, msg *pb.ListFluxCrdsRequ
 	return &pb.ListFluxCrdsResponse{Crds: results, Errors: respErrors}, nil
 }
 
-func filterFluxNamespace(nss []v1.Namespace) []v1.Namespace {
-	fluxSystem := []v1.Namespace{}
+func (cs *coreServer) GetReconciledObjects(ctx context.Context, msg *pb.GetReconciledObjectsRequest) (*pb.GetReconciledObjectsResponse, error) {
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+	if err != nil {
+		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	var result []unstructured.Unstructured
 
-	for _, ns := range nss {
-		if val, ok := ns.Labels[coretypes.PartOfLabel]; ok && val == FluxNamespacePartOf {
-			fluxSystem = append(fluxSystem, ns)
-			continue
+	if msg.AutomationKind == kustomizev1.KustomizationKind {
+		result, err = cs.getReconciledObjectsFromInventory(ctx, clustersClient, msg)
+		if err != nil {
+			return nil, err
 		}
+	}
 
-		if ns.Name == DefaultFluxNamespace {
-			fluxSystem = append(fluxSystem, ns)
+	if result == nil {
+		result, err = cs.getReconciledObjectsByLabels(ctx, clustersClient, msg)
+		if err != nil {
+			return nil, err
 		}
 	}
 
-	return fluxSystem
+	clusterUserNamespaces := cs.clustersManager.GetUserNamespaces(auth.Principal(ctx))
+
+	objects := []*pb.Object{}
+	respErrors := multierror.Error{}
+
+	for _, unstructuredObj := range result {
+		tenant := GetTenant(unstructuredObj.GetNamespace(), msg.ClusterName, clusterUserNamespaces)
+
+		var o *pb.Object
+
+		var obj client.Object = &unstructuredObj
+
+		if unstructuredObj.GetKind() == "Secret" {
+			obj, err = sanitizeSecret(&unstructuredObj)
+			if err != nil {
+				respErrors = *multierror.Append(fmt.Errorf("error sanitizing secrets: %w", err), respErrors.Errors...)
+				continue
+			}
+		}
+
+		o, err = coretypes.K8sObjectToProto(obj, msg.ClusterName, tenant, nil)
+		if err != nil {
+			respErrors = *multierror.Append(fmt.Errorf("error converting objects: %w", err), respErrors.Errors...)
+			continue
+		}
+
+		objects = append(objects, o)
+	}
+
+	return &pb.GetReconciledObjectsResponse{Objects: objects}, respErrors.ErrorOrNil()
 }
 
-func (cs *coreServer) GetReconciledObjects(ctx context.Context, msg *pb.GetReconciledObjectsRequest) (*pb.GetReconciledObjectsResponse, error) {
-	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
+// getReconciledObjectsFromInventory returns the objects in the Kustomization's
+// inventory, or nil if there is no inventory to go by.
+func (cs *coreServer) getReconciledObjectsFromInventory(ctx context.Context, clustersClient clustersmngr.Client, msg *pb.GetReconciledObjectsRequest) ([]unstructured.Unstructured, error) {
+	kustomization := kustomizev1.Kustomization{}
+	key := client.ObjectKey{Name: msg.AutomationName, Namespace: msg.Namespace}
+
+	if err := clustersClient.Get(ctx, msg.ClusterName, key, &kustomization); err != nil {
+		cs.logger.V(logger.LogLevelDebug).Info("couldn't get kustomization, falling back to labels", "kustomization", key, "error", err)
+		return nil, nil
+	}
+
+	if kustomization.Status.Inventory == nil {
+		return nil, nil
+	}
+
+	result, err := getKustomizationInventory(ctx, cs.logger, kustomization, msg.Kinds, clustersClient, msg.ClusterName)
 	if err != nil {
-		return nil, fmt.Errorf("error getting impersonating client: %w", err)
+		// Show whatever could be fetched, same as when listing by labels
+		cs.logger.Error(err, "failed getting some inventory entries", "kustomization", key)
 	}
 
+	return result, nil
+}
+
+func (cs *coreServer) getReconciledObjectsByLabels(ctx context.Context, clustersClient clustersmngr.Client, msg *pb.GetReconciledObjectsRequest) ([]unstructured.Unstructured, error) {
 	var opts client.MatchingLabels
 
 	switch msg.AutomationKind {
@@ -298,34 +383,11 @@ func (cs *coreServer) GetReconciledObjects(ctx context.Context, msg *pb.GetRecon
 
 	wg.Wait()
 
-	objects := []*pb.Object{}
-	respErrors := multierror.Error{}
-
-	for _, unstructuredObj := range result {
-		tenant := GetTenant(unstructuredObj.GetNamespace(), msg.ClusterName, clusterUserNamespaces)
-
-		var o *pb.Object
-
-		var obj client.Object = &unstructuredObj
-
-		if unstructuredObj.GetKind() == "Secret" {
-			obj, err = sanitizeSecret(&unstructuredObj)
-			if err != nil {
-				respErrors = *multierror.Append(fmt.Errorf("error sanitizing secrets: %w", err), respErrors.Errors...)
-				continue
-			}
-		}
-
-		o, err = coretypes.K8sObjectToProto(obj, msg.ClusterName, tenant, nil)
-		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("error converting objects: %w", err), respErrors.Errors...)
-			continue
-		}
-
-		objects = append(objects, o)
+	if err := errs.ErrorOrNil(); err != nil {
+		cs.logger.V(logger.LogLevelDebug).Info("failed listing some reconciled objects", "automation", msg.AutomationName, "error", err)
 	}
 
-	return &pb.GetReconciledObjectsResponse{Objects: objects}, respErrors.ErrorOrNil()
+	return result, nil
 }
 
 func (cs *coreServer) GetChildObjects(ctx context.Context, msg *pb.GetChildObjectsRequest) (*pb.GetChildObjectsResponse, error) {
@@ -8,6 +8,7 @@ import (
 
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
 	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
 	"github.com/weaveworks/weave-gitops/core/server"
 	stypes "github.com/weaveworks/weave-gitops/core/server/types"
@@ -241,6 +242,130 @@ func TestGetReconciledObjectsWithSecret(t *testing.T) {
 	g.Expect(first.Payload).To(ContainSubstring("redacted"))
 }
 
+func TestGetReconciledObjectsFromInventory(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-automation", Namespace: ns.Name},
+		Status: kustomizev1.KustomizationStatus{
+			Inventory: &kustomizev1.ResourceInventory{
+				Entries: []kustomizev1.ResourceRef{
+					{ID: ns.Name + "_my-deployment_apps_Deployment", Version: "v1"},
+					{ID: ns.Name + "_my-configmap__ConfigMap", Version: "v1"},
+					{ID: ns.Name + "_deleted__ConfigMap", Version: "v1"},
+				},
+			},
+		},
+	}
+	// No labels, as if they'd been stripped after being applied
+	deployment := newDeployment("my-deployment", ns.Name, nil)
+	configMap := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "my-configmap", Namespace: ns.Name}}
+	labelled := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{
+		Name:      "not-in-inventory",
+		Namespace: ns.Name,
+		Labels: map[string]string{
+			server.KustomizeNameKey:      "my-automation",
+			server.KustomizeNamespaceKey: ns.Name,
+		},
+	}}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(ns, kust, deployment, configMap, labelled).Build()
+	c := makeServer(makeServerConfig(fakeClient, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	request := &pb.GetReconciledObjectsRequest{
+		AutomationName: "my-automation",
+		Namespace:      ns.Name,
+		AutomationKind: kustomizev1.KustomizationKind,
+		Kinds: []*pb.GroupVersionKind{
+			{Group: appsv1.SchemeGroupVersion.Group, Version: appsv1.SchemeGroupVersion.Version, Kind: "Deployment"},
+			{Group: corev1.SchemeGroupVersion.Group, Version: corev1.SchemeGroupVersion.Version, Kind: "ConfigMap"},
+		},
+		ClusterName: "Default",
+	}
+
+	res, err := c.GetReconciledObjects(outgoingCtx, request)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objectNames(g, res.Objects)).To(ConsistOf("my-deployment", "my-configmap"))
+
+	// Only the requested kinds are fetched
+	request.Kinds = request.Kinds[1:]
+	res, err = c.GetReconciledObjects(outgoingCtx, request)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objectNames(g, res.Objects)).To(ConsistOf("my-configmap"))
+
+	// Without an inventory, labels are used
+	kust.Status.Inventory = nil
+	g.Expect(fakeClient.Status().Update(ctx, kust)).To(Succeed())
+
+	res, err = c.GetReconciledObjects(outgoingCtx, request)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objectNames(g, res.Objects)).To(ConsistOf("not-in-inventory"))
+}
+
+func TestGetReconciledObjectsFromALargeInventory(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-automation", Namespace: ns.Name},
+		Status: kustomizev1.KustomizationStatus{
+			Inventory: &kustomizev1.ResourceInventory{},
+		},
+	}
+
+	objects := []runtime.Object{ns}
+	names := []string{}
+
+	// More entries than are fetched at once.
+	for i := 0; i < 25; i++ {
+		name := fmt.Sprintf("configmap-%d", i)
+		kust.Status.Inventory.Entries = append(kust.Status.Inventory.Entries, kustomizev1.ResourceRef{ID: ns.Name + "_" + name + "__ConfigMap", Version: "v1"})
+		objects = append(objects, &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: ns.Name}})
+		names = append(names, name)
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(append(objects, kust)...).Build()
+	c := makeServer(makeServerConfig(fakeClient, t), t)
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters"))
+
+	res, err := c.GetReconciledObjects(ctx, &pb.GetReconciledObjectsRequest{
+		AutomationName: "my-automation",
+		Namespace:      ns.Name,
+		AutomationKind: kustomizev1.KustomizationKind,
+		ClusterName:    "Default",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(objectNames(g, res.Objects)).To(ConsistOf(names))
+}
+
+func objectNames(g *WithT, objects []*pb.Object) []string {
+	names := []string{}
+
+	for _, obj := range objects {
+		var object map[string]interface{}
+
+		g.Expect(json.Unmarshal([]byte(obj.Payload), &object)).To(Succeed())
+		names = append(names, object["metadata"].(map[string]interface{})["name"].(string))
+	}
+
+	return names
+}
+
 func TestGetChildObjects(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -391,6 +516,87 @@ func TestListFluxRuntimeObjects(t *testing.T) {
 	}
 }
 
+func TestListFluxRuntimeObjectsPagination(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fluxLabels := map[string]string{stypes.PartOfLabel: server.FluxNamespacePartOf}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-ns", Labels: fluxLabels}},
+		newDeployment("helm-controller", "flux-ns", fluxLabels),
+		newDeployment("kustomize-controller", "flux-ns", fluxLabels),
+		newDeployment("source-controller", "flux-ns", fluxLabels),
+	).Build()
+
+	cfg := makeServerConfig(pagingClient{client}, t)
+	c := makeServer(cfg, t)
+
+	res, err := c.ListFluxRuntimeObjects(ctx, &pb.ListFluxRuntimeObjectsRequest{
+		Pagination: &pb.Pagination{PageSize: 2},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Deployments).To(HaveLen(2))
+	g.Expect(res.NextToken).NotTo(BeEmpty())
+
+	paginationInfo, err := clustersmngr.DecodePaginationInfo(res.NextToken)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(paginationInfo.Get(cluster.DefaultCluster, "flux-ns")).To(Equal("2"))
+
+	names := []string{res.Deployments[0].Name, res.Deployments[1].Name}
+
+	res, err = c.ListFluxRuntimeObjects(ctx, &pb.ListFluxRuntimeObjectsRequest{
+		Pagination: &pb.Pagination{PageSize: 2, PageToken: res.NextToken},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Deployments).To(HaveLen(1))
+	g.Expect(res.NextToken).To(BeEmpty())
+
+	names = append(names, res.Deployments[0].Name)
+	g.Expect(names).To(Equal([]string{"helm-controller", "kustomize-controller", "source-controller"}))
+}
+
+func TestListFluxRuntimeObjectsRetriesNamespacesThatFailed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fluxLabels := map[string]string{stypes.PartOfLabel: server.FluxNamespacePartOf}
+
+	client := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-ns", Labels: fluxLabels}},
+		newDeployment("helm-controller", "flux-ns", fluxLabels),
+	).Build()
+
+	cfg := makeServerConfig(flakyClient{Client: client, failed: new(bool)}, t)
+	c := makeServer(cfg, t)
+
+	res, err := c.ListFluxRuntimeObjects(ctx, &pb.ListFluxRuntimeObjectsRequest{
+		Pagination: &pb.Pagination{PageSize: 2},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Deployments).To(BeEmpty())
+	g.Expect(res.Errors).To(HaveLen(1))
+	g.Expect(res.Errors[0].Namespace).To(Equal("flux-ns"))
+	g.Expect(res.NextToken).NotTo(BeEmpty())
+
+	res, err = c.ListFluxRuntimeObjects(ctx, &pb.ListFluxRuntimeObjectsRequest{
+		Pagination: &pb.Pagination{PageSize: 2, PageToken: res.NextToken},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Errors).To(BeEmpty())
+	g.Expect(res.Deployments).To(HaveLen(1))
+	g.Expect(res.Deployments[0].Name).To(Equal("helm-controller"))
+	g.Expect(res.NextToken).To(BeEmpty())
+}
+
 func newDeployment(name, ns string, labels map[string]string) *appsv1.Deployment {
 	return &appsv1.Deployment{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,430 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	"github.com/pmezard/go-difflib/difflib"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/core/server/types"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	diffContextLines = 3
+	redactedValue    = "redacted"
+)
+
+var yamlDocumentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// GetHelmReleaseHistory reads the release history from Helm's storage secrets
+// with the server's permissions, so that users who can get a HelmRelease can
+// see its history without having access to secrets. As the manifests and
+// values can hold secrets, Secrets in the manifests and values read from
+// valuesFrom Secrets are redacted. Storage secrets that can't be decoded are
+// reported and left out of the history.
+func (cs *coreServer) GetHelmReleaseHistory(ctx context.Context, msg *pb.GetHelmReleaseHistoryRequest) (*pb.GetHelmReleaseHistoryResponse, error) {
+	key := client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}
+
+	allowed, err := cs.canGetHelmRelease(ctx, auth.Principal(ctx), msg.ClusterName, key)
+	if err != nil {
+		return nil, fmt.Errorf("checking access to helm release: %w", err)
+	}
+
+	if !allowed {
+		return nil, status.Errorf(codes.PermissionDenied, "not allowed to get helm release %s", key)
+	}
+
+	clustersClient, err := cs.clustersManager.GetServerClient(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("error getting server client: %w", err)
+	}
+
+	helmRelease := helmv2.HelmRelease{}
+
+	if err := clustersClient.Get(ctx, msg.ClusterName, key, &helmRelease); err != nil {
+		return nil, fmt.Errorf("getting helm release: %w", err)
+	}
+
+	revisions, skipped, err := getHelmReleaseRevisions(ctx, helmRelease, clustersClient, msg.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &pb.GetHelmReleaseHistoryResponse{Revisions: revisions}
+
+	for _, err := range skipped {
+		res.Errors = append(res.Errors, &pb.ListError{
+			ClusterName: msg.ClusterName,
+			Namespace:   helmRelease.GetStorageNamespace(),
+			Message:     err.Error(),
+		})
+	}
+
+	if msg.DiffFrom != 0 && msg.DiffTo != 0 {
+		from := findHelmReleaseRevision(revisions, msg.DiffFrom)
+		to := findHelmReleaseRevision(revisions, msg.DiffTo)
+
+		if from == nil || to == nil {
+			return nil, status.Errorf(codes.InvalidArgument, "revisions %d and %d must both be in the release history", msg.DiffFrom, msg.DiffTo)
+		}
+
+		res.Diff, err = diffHelmReleaseRevisions(from, to)
+		if err != nil {
+			return nil, fmt.Errorf("diffing revisions: %w", err)
+		}
+	}
+
+	return res, nil
+}
+
+// canGetHelmRelease checks with a SelfSubjectAccessReview that the user can
+// get the HelmRelease, before anything about it is read with the server's
+// permissions.
+func (cs *coreServer) canGetHelmRelease(ctx context.Context, principal *auth.UserPrincipal, clusterName string, key client.ObjectKey) (bool, error) {
+	var cl cluster.Cluster
+
+	for _, c := range cs.clustersManager.GetClusters() {
+		if c.GetName() == clusterName {
+			cl = c
+			break
+		}
+	}
+
+	if cl == nil {
+		return false, clustersmngr.ClusterNotFoundError{Cluster: clusterName}
+	}
+
+	clientset, err := cl.GetUserClientset(principal)
+	if err != nil {
+		return false, fmt.Errorf("getting user clientset: %w", err)
+	}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: key.Namespace,
+				Verb:      "get",
+				Group:     helmv2.GroupVersion.Group,
+				Resource:  "helmreleases",
+				Name:      key.Name,
+			},
+		},
+	}
+
+	res, err := clientset.AuthorizationV1().SelfSubjectAccessReviews().Create(ctx, review, metav1.CreateOptions{})
+	if err != nil {
+		return false, err
+	}
+
+	return res.Status.Allowed, nil
+}
+
+// getHelmReleaseRevisions returns every revision of the release in Helm's
+// storage, newest first, and why the revisions that couldn't be read were
+// skipped. Secrets in the manifests and the values read from valuesFrom
+// Secrets are redacted.
+func getHelmReleaseRevisions(ctx context.Context, helmRelease helmv2.HelmRelease, c clustersmngr.Client, cluster string) ([]*pb.HelmReleaseRevision, []error, error) {
+	storages, skipped, err := getHelmReleaseStorages(ctx, helmRelease, c, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secretPaths, err := secretValuesPaths(ctx, helmRelease, c, cluster)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	revisions := []*pb.HelmReleaseRevision{}
+
+	for _, storage := range storages {
+		manifest, err := redactManifestSecrets(storage.Manifest)
+		if err != nil {
+			skipped = append(skipped, fmt.Errorf("skipping revision %d: %w", storage.Version, err))
+			continue
+		}
+
+		merged := mergeHelmValues(storage.Chart.Values, storage.Config)
+
+		for _, path := range secretPaths {
+			redactHelmValue(merged, path)
+		}
+
+		values, err := yaml.Marshal(merged)
+		if err != nil {
+			return nil, nil, fmt.Errorf("encoding values of revision %d: %w", storage.Version, err)
+		}
+
+		revision := &pb.HelmReleaseRevision{
+			Revision:     int32(storage.Version),
+			Status:       storage.Info.Status,
+			Description:  storage.Info.Description,
+			ChartName:    storage.Chart.Metadata.Name,
+			ChartVersion: storage.Chart.Metadata.Version,
+			AppVersion:   storage.Chart.Metadata.AppVersion,
+			Values:       string(values),
+			Manifest:     manifest,
+		}
+
+		if !storage.Info.LastDeployed.IsZero() {
+			revision.LastDeployed = storage.Info.LastDeployed.Format(time.RFC3339)
+		}
+
+		revisions = append(revisions, revision)
+	}
+
+	return revisions, skipped, nil
+}
+
+// getHelmReleaseStorages decodes all the storage secrets of the release,
+// newest first. One broken secret doesn't hide the rest of the history, the
+// errors decoding the secrets that were skipped are returned separately.
+func getHelmReleaseStorages(ctx context.Context, helmRelease helmv2.HelmRelease, c clustersmngr.Client, cluster string) ([]*types.HelmReleaseStorage, []error, error) {
+	secrets := &v1.SecretList{}
+
+	opts := []client.ListOption{
+		client.InNamespace(helmRelease.GetStorageNamespace()),
+		client.MatchingLabels{
+			"owner": "helm",
+			"name":  helmRelease.GetReleaseName(),
+		},
+	}
+
+	if err := c.List(ctx, cluster, secrets, opts...); err != nil {
+		return nil, nil, fmt.Errorf("listing helm storage secrets: %w", err)
+	}
+
+	storages := []*types.HelmReleaseStorage{}
+
+	var skipped []error
+
+	for i := range secrets.Items {
+		storage, err := decodeHelmReleaseStorage(&secrets.Items[i], helmRelease.Name)
+		if err != nil {
+			skipped = append(skipped, fmt.Errorf("skipping helm storage secret %s: %w", secrets.Items[i].Name, err))
+			continue
+		}
+
+		storages = append(storages, storage)
+	}
+
+	sort.Slice(storages, func(i, j int) bool {
+		return storages[i].Version > storages[j].Version
+	})
+
+	return storages, skipped, nil
+}
+
+// secretValuesPaths returns the paths of the values the HelmRelease reads
+// from Secrets through valuesFrom. Those are the paths in the current
+// Secrets, values that were only in older versions of them can't be told
+// apart from the others.
+func secretValuesPaths(ctx context.Context, helmRelease helmv2.HelmRelease, c clustersmngr.Client, cluster string) ([][]string, error) {
+	paths := [][]string{}
+
+	for _, ref := range helmRelease.Spec.ValuesFrom {
+		if ref.Kind != "Secret" {
+			continue
+		}
+
+		secret := &v1.Secret{}
+		key := client.ObjectKey{Name: ref.Name, Namespace: helmRelease.Namespace}
+
+		if err := c.Get(ctx, cluster, key, secret); err != nil {
+			if apierrors.IsNotFound(err) && ref.Optional {
+				continue
+			}
+
+			return nil, fmt.Errorf("getting values secret %s: %w", key, err)
+		}
+
+		data, ok := secret.Data[ref.GetValuesKey()]
+		if !ok {
+			continue
+		}
+
+		if ref.TargetPath != "" {
+			paths = append(paths, strings.Split(ref.TargetPath, "."))
+			continue
+		}
+
+		values := map[string]interface{}{}
+		if err := yaml.Unmarshal(data, &values); err != nil {
+			return nil, fmt.Errorf("decoding values secret %s: %w", key, err)
+		}
+
+		paths = append(paths, valuesPaths(values, nil)...)
+	}
+
+	return paths, nil
+}
+
+// valuesPaths returns the paths of all the leaves of the values.
+func valuesPaths(values map[string]interface{}, prefix []string) [][]string {
+	paths := [][]string{}
+
+	for k, v := range values {
+		path := append(append([]string{}, prefix...), k)
+
+		if m, ok := v.(map[string]interface{}); ok && len(m) > 0 {
+			paths = append(paths, valuesPaths(m, path)...)
+			continue
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+// redactHelmValue replaces the value at the path, if there is one.
+func redactHelmValue(values map[string]interface{}, path []string) {
+	for i, k := range path {
+		v, ok := values[k]
+		if !ok {
+			return
+		}
+
+		if i == len(path)-1 {
+			values[k] = redactedValue
+			return
+		}
+
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return
+		}
+
+		// The maps may be shared with the chart defaults, so they're copied
+		// before they're changed.
+		copied := make(map[string]interface{}, len(m))
+		for mk, mv := range m {
+			copied[mk] = mv
+		}
+
+		values[k] = copied
+		values = copied
+	}
+}
+
+// redactManifestSecrets redacts the data of the Secrets in a rendered
+// manifest, the same way the Secrets listed by the server are.
+func redactManifestSecrets(manifest string) (string, error) {
+	docs := yamlDocumentSeparator.Split(manifest, -1)
+
+	for i, doc := range docs {
+		obj := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(doc), &obj); err != nil {
+			return "", fmt.Errorf("decoding manifest: %w", err)
+		}
+
+		if obj["kind"] != "Secret" {
+			continue
+		}
+
+		delete(obj, "stringData")
+		obj["data"] = map[string]interface{}{"redacted": nil}
+
+		redacted, err := yaml.Marshal(obj)
+		if err != nil {
+			return "", fmt.Errorf("encoding manifest: %w", err)
+		}
+
+		// Keep the comments Helm adds with the template each document
+		// comes from.
+		var comments strings.Builder
+
+		for _, line := range strings.SplitAfter(doc, "\n") {
+			if trimmed := strings.TrimSpace(line); trimmed != "" && !strings.HasPrefix(trimmed, "#") {
+				break
+			}
+
+			comments.WriteString(line)
+		}
+
+		docs[i] = comments.String() + string(redacted)
+	}
+
+	return strings.Join(docs, "---"), nil
+}
+
+func findHelmReleaseRevision(revisions []*pb.HelmReleaseRevision, revision int32) *pb.HelmReleaseRevision {
+	for _, r := range revisions {
+		if r.Revision == revision {
+			return r
+		}
+	}
+
+	return nil
+}
+
+// mergeHelmValues works out the values a release was rendered with, the same
+// way Helm does: the supplied values override the chart defaults, maps are
+// merged, and a null supplied value removes the default.
+func mergeHelmValues(defaults, overrides map[string]interface{}) map[string]interface{} {
+	result := make(map[string]interface{}, len(defaults))
+
+	for k, v := range defaults {
+		result[k] = v
+	}
+
+	for k, v := range overrides {
+		if v == nil {
+			delete(result, k)
+			continue
+		}
+
+		overrideMap, isMap := v.(map[string]interface{})
+		defaultMap, defaultIsMap := result[k].(map[string]interface{})
+
+		if isMap && defaultIsMap {
+			result[k] = mergeHelmValues(defaultMap, overrideMap)
+			continue
+		}
+
+		result[k] = v
+	}
+
+	return result
+}
+
+func diffHelmReleaseRevisions(from, to *pb.HelmReleaseRevision) (string, error) {
+	values, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.Values),
+		B:        difflib.SplitLines(to.Values),
+		FromFile: fmt.Sprintf("values (revision %d)", from.Revision),
+		ToFile:   fmt.Sprintf("values (revision %d)", to.Revision),
+		Context:  diffContextLines,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	manifest, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(from.Manifest),
+		B:        difflib.SplitLines(to.Manifest),
+		FromFile: fmt.Sprintf("manifest (revision %d)", from.Revision),
+		ToFile:   fmt.Sprintf("manifest (revision %d)", to.Revision),
+		Context:  diffContextLines,
+	})
+	if err != nil {
+		return "", err
+	}
+
+	return values + manifest, nil
+}
@@ -0,0 +1,435 @@
+package server_test
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	"github.com/fluxcd/pkg/apis/meta"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestGetHelmReleaseHistory(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: ns.Name},
+	}
+
+	chartValues := map[string]interface{}{
+		"replicaCount": 1,
+		"image":        map[string]interface{}{"repository": "podinfo", "tag": "6.0.0"},
+	}
+
+	objects := []client.Object{
+		ns,
+		hr,
+		helmStorageSecret(g, ns.Name, "podinfo", 1, "superseded", "6.0.0", chartValues, nil),
+		helmStorageSecret(g, ns.Name, "podinfo", 2, "deployed", "6.1.0", chartValues, map[string]interface{}{
+			"replicaCount": 3,
+			"image":        map[string]interface{}{"tag": "6.1.0"},
+		}),
+		helmStorageSecret(g, ns.Name, "another-release", 1, "deployed", "1.0.0", nil, nil),
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+	c := makeServer(makeServerConfig(fakeClient, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	res, err := c.GetHelmReleaseHistory(outgoingCtx, &pb.GetHelmReleaseHistoryRequest{
+		Name:        hr.Name,
+		Namespace:   hr.Namespace,
+		ClusterName: "Default",
+		DiffFrom:    1,
+		DiffTo:      2,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(res.Revisions).To(HaveLen(2))
+
+	latest := res.Revisions[0]
+	g.Expect(latest.Revision).To(Equal(int32(2)))
+	g.Expect(latest.Status).To(Equal("deployed"))
+	g.Expect(latest.ChartName).To(Equal("podinfo"))
+	g.Expect(latest.ChartVersion).To(Equal("6.1.0"))
+	g.Expect(latest.Manifest).To(ContainSubstring("revision: 2"))
+	g.Expect(latest.Values).To(Equal("image:\n  repository: podinfo\n  tag: 6.1.0\nreplicaCount: 3\n"))
+
+	g.Expect(res.Diff).To(ContainSubstring("--- values (revision 1)"))
+	g.Expect(res.Diff).To(ContainSubstring("-  tag: 6.0.0\n-replicaCount: 1\n+  tag: 6.1.0\n+replicaCount: 3\n"))
+	g.Expect(res.Diff).To(ContainSubstring("-  revision: 1\n+  revision: 2\n"))
+
+	_, err = c.GetHelmReleaseHistory(outgoingCtx, &pb.GetHelmReleaseHistoryRequest{
+		Name:        hr.Name,
+		Namespace:   hr.Namespace,
+		ClusterName: "Default",
+		DiffFrom:    1,
+		DiffTo:      5,
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("must both be in the release history")))
+}
+
+func TestGetHelmReleaseHistorySkipsBrokenStorage(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: ns.Name},
+	}
+
+	broken := helmStorageSecret(g, ns.Name, "podinfo", 1, "superseded", "6.0.0", nil, nil)
+	broken.Data["release"] = []byte("not a release")
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		ns,
+		hr,
+		broken,
+		helmStorageSecret(g, ns.Name, "podinfo", 2, "deployed", "6.1.0", nil, nil),
+	).Build()
+	c := makeServer(makeServerConfig(fakeClient, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	res, err := c.GetHelmReleaseHistory(outgoingCtx, &pb.GetHelmReleaseHistoryRequest{
+		Name:        hr.Name,
+		Namespace:   hr.Namespace,
+		ClusterName: "Default",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(res.Revisions).To(HaveLen(1))
+	g.Expect(res.Revisions[0].Revision).To(Equal(int32(2)))
+
+	g.Expect(res.Errors).To(HaveLen(1))
+	g.Expect(res.Errors[0].Message).To(ContainSubstring(broken.Name))
+}
+
+func TestGetHelmReleaseHistoryRedactsSecrets(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: ns.Name},
+		Spec: helmv2.HelmReleaseSpec{
+			ValuesFrom: []helmv2.ValuesReference{
+				{Kind: "Secret", Name: "podinfo-values"},
+				{Kind: "Secret", Name: "podinfo-token", ValuesKey: "token", TargetPath: "auth.token"},
+				{Kind: "Secret", Name: "missing", Optional: true},
+			},
+		},
+	}
+
+	valuesSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo-values", Namespace: ns.Name},
+		Data:       map[string][]byte{"values.yaml": []byte("database:\n  password: hunter2\n")},
+	}
+
+	tokenSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo-token", Namespace: ns.Name},
+		Data:       map[string][]byte{"token": []byte("s3cr3t")},
+	}
+
+	manifest := "---\n# Source: podinfo/templates/secret.yaml\napiVersion: v1\nkind: Secret\nmetadata:\n  name: podinfo\nstringData:\n  password: hunter2\n" +
+		"---\n# Source: podinfo/templates/configmap.yaml\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: podinfo\ndata:\n  color: blue\n"
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		ns,
+		hr,
+		valuesSecret,
+		tokenSecret,
+		helmStorageSecretWithManifest(g, ns.Name, "podinfo", 1, "deployed", "6.0.0", map[string]interface{}{
+			"database": map[string]interface{}{"host": "db", "password": ""},
+		}, map[string]interface{}{
+			"database": map[string]interface{}{"password": "hunter2"},
+			"auth":     map[string]interface{}{"token": "s3cr3t"},
+		}, manifest),
+	).Build()
+	c := makeServer(makeServerConfig(fakeClient, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	res, err := c.GetHelmReleaseHistory(outgoingCtx, &pb.GetHelmReleaseHistoryRequest{
+		Name:        hr.Name,
+		Namespace:   hr.Namespace,
+		ClusterName: "Default",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Revisions).To(HaveLen(1))
+
+	revision := res.Revisions[0]
+	g.Expect(revision.Values).To(Equal("auth:\n  token: redacted\ndatabase:\n  host: db\n  password: redacted\n"))
+	g.Expect(revision.Manifest).NotTo(ContainSubstring("hunter2"))
+	g.Expect(revision.Manifest).To(ContainSubstring("# Source: podinfo/templates/secret.yaml\napiVersion: v1\ndata:\n  redacted: null\nkind: Secret\n"))
+	g.Expect(revision.Manifest).To(ContainSubstring("# Source: podinfo/templates/configmap.yaml\napiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: podinfo\ndata:\n  color: blue\n"))
+}
+
+func TestGetHelmReleaseHistoryChecksAccessToTheHelmRelease(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: ns.Name},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		ns,
+		hr,
+		helmStorageSecret(g, ns.Name, "podinfo", 1, "deployed", "6.0.0", nil, nil),
+	).Build()
+
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", reviewReactor(false))
+
+	c := makeServer(makeServerConfigWithClientset(fakeClient, clientset, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "team-a")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	_, err = c.GetHelmReleaseHistory(outgoingCtx, &pb.GetHelmReleaseHistoryRequest{
+		Name:        hr.Name,
+		Namespace:   hr.Namespace,
+		ClusterName: "Default",
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+
+	review, ok := clientset.Actions()[0].(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+	g.Expect(ok).To(BeTrue())
+	g.Expect(*review.Spec.ResourceAttributes).To(Equal(authorizationv1.ResourceAttributes{
+		Namespace: hr.Namespace,
+		Verb:      "get",
+		Group:     helmv2.GroupVersion.Group,
+		Resource:  "helmreleases",
+		Name:      hr.Name,
+	}))
+}
+
+func helmStorageSecret(g *WithT, namespace, releaseName string, version int, status, chartVersion string, values, config map[string]interface{}) *corev1.Secret {
+	manifest := fmt.Sprintf("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: podinfo\ndata:\n  revision: %d\n", version)
+
+	return helmStorageSecretWithManifest(g, namespace, releaseName, version, status, chartVersion, values, config, manifest)
+}
+
+func helmStorageSecretWithManifest(g *WithT, namespace, releaseName string, version int, status, chartVersion string, values, config map[string]interface{}, manifest string) *corev1.Secret {
+	release := map[string]interface{}{
+		"name":    releaseName,
+		"version": version,
+		"info": map[string]interface{}{
+			"status":        status,
+			"last_deployed": "2022-11-01T10:00:00Z",
+		},
+		"chart": map[string]interface{}{
+			"metadata": map[string]interface{}{
+				"name":       "podinfo",
+				"version":    chartVersion,
+				"appVersion": chartVersion,
+			},
+			"values": values,
+		},
+		"config":   config,
+		"manifest": manifest,
+	}
+
+	data, err := json.Marshal(release)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	var buf bytes.Buffer
+
+	w := gzip.NewWriter(&buf)
+	_, err = w.Write(data)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(w.Close()).To(Succeed())
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("sh.helm.release.v1.%s.v%d", releaseName, version),
+			Namespace: namespace,
+			Labels: map[string]string{
+				"owner":   "helm",
+				"name":    releaseName,
+				"version": fmt.Sprint(version),
+			},
+		},
+		Type: "helm.sh/release.v1",
+		Data: map[string][]byte{
+			"release": []byte(base64.StdEncoding.EncodeToString(buf.Bytes())),
+		},
+	}
+}
+
+func TestRollbackHelmRelease(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	hr := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "podinfo", Namespace: ns.Name},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:     "podinfo",
+					Version:   "6.1.0",
+					SourceRef: helmv2.CrossNamespaceObjectReference{Kind: sourcev1.HelmRepositoryKind, Name: "podinfo"},
+				},
+			},
+			Values: &apiextensionsv1.JSON{Raw: []byte(`{"replicaCount":3}`)},
+		},
+	}
+
+	fromGit := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "from-git", Namespace: ns.Name},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:     "./charts/podinfo",
+					SourceRef: helmv2.CrossNamespaceObjectReference{Kind: sourcev1.GitRepositoryKind, Name: "podinfo"},
+				},
+			},
+		},
+	}
+
+	withValuesFrom := &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{Name: "with-values-from", Namespace: ns.Name},
+		Spec: helmv2.HelmReleaseSpec{
+			Chart: helmv2.HelmChartTemplate{
+				Spec: helmv2.HelmChartTemplateSpec{
+					Chart:     "podinfo",
+					Version:   "6.1.0",
+					SourceRef: helmv2.CrossNamespaceObjectReference{Kind: sourcev1.HelmRepositoryKind, Name: "podinfo"},
+				},
+			},
+			ValuesFrom: []helmv2.ValuesReference{{Kind: "Secret", Name: "podinfo-values"}},
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		ns,
+		hr,
+		fromGit,
+		withValuesFrom,
+		helmStorageSecret(g, ns.Name, "podinfo", 1, "superseded", "6.0.0", nil, map[string]interface{}{"replicaCount": 2}),
+		helmStorageSecret(g, ns.Name, "podinfo", 2, "deployed", "6.1.0", nil, map[string]interface{}{"replicaCount": 3}),
+		helmStorageSecret(g, ns.Name, "with-values-from", 1, "superseded", "6.0.0", nil, map[string]interface{}{"password": "old"}),
+		helmStorageSecret(g, ns.Name, "with-values-from", 2, "deployed", "6.1.0", nil, map[string]interface{}{"password": "new"}),
+	).Build()
+	c := makeServer(makeServerConfig(fakeClient, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	_, err = c.RollbackHelmRelease(outgoingCtx, &pb.RollbackHelmReleaseRequest{
+		Name:        hr.Name,
+		Namespace:   hr.Namespace,
+		ClusterName: "Default",
+		Revision:    5,
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("not in the release history")))
+
+	_, err = c.RollbackHelmRelease(outgoingCtx, &pb.RollbackHelmReleaseRequest{
+		Name:        fromGit.Name,
+		Namespace:   fromGit.Namespace,
+		ClusterName: "Default",
+		Revision:    1,
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.InvalidArgument))
+
+	_, err = c.RollbackHelmRelease(outgoingCtx, &pb.RollbackHelmReleaseRequest{
+		Name:        withValuesFrom.Name,
+		Namespace:   withValuesFrom.Namespace,
+		ClusterName: "Default",
+		Revision:    1,
+	})
+	g.Expect(status.Code(err)).To(Equal(codes.FailedPrecondition))
+	g.Expect(err).To(MatchError(ContainSubstring("values differ")))
+
+	notRolledBack := &helmv2.HelmRelease{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(withValuesFrom), notRolledBack)).To(Succeed())
+	g.Expect(notRolledBack.Spec.Chart.Spec.Version).To(Equal("6.1.0"))
+
+	done := make(chan error)
+
+	go func() {
+		_, err := c.RollbackHelmRelease(outgoingCtx, &pb.RollbackHelmReleaseRequest{
+			Name:        hr.Name,
+			Namespace:   hr.Namespace,
+			ClusterName: "Default",
+			Revision:    1,
+		})
+		done <- err
+	}()
+
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+
+reconciling:
+	for {
+		select {
+		case <-ticker.C:
+			if err := simulateReconcile(ctx, fakeClient, client.ObjectKeyFromObject(hr), &helmv2.HelmRelease{}); err != nil {
+				t.Fatal(err)
+			}
+		case err := <-done:
+			g.Expect(err).NotTo(HaveOccurred())
+			break reconciling
+		}
+	}
+
+	updated := &helmv2.HelmRelease{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(hr), updated)).To(Succeed())
+	g.Expect(updated.Spec.Chart.Spec.Version).To(Equal("6.0.0"))
+	g.Expect(string(updated.Spec.Values.Raw)).To(Equal(`{"replicaCount":2}`))
+	g.Expect(updated.GetAnnotations()).To(HaveKey(meta.ReconcileRequestAnnotation))
+}
@@ -41,38 +41,11 @@ func getHelmReleaseInventory(ctx context.Context, helmRelease helmv2.HelmRelease
 		return nil, err
 	}
 
-	releaseData, releaseFound := storageSecret.Data["release"]
-	if !releaseFound {
-		return nil, fmt.Errorf("failed to decode the Helm storage object for HelmRelease '%s'", helmRelease.Name)
-	}
-
-	byteData, err := base64.StdEncoding.DecodeString(string(releaseData))
+	storage, err := decodeHelmReleaseStorage(storageSecret, helmRelease.Name)
 	if err != nil {
 		return nil, err
 	}
 
-	var magicGzip = []byte{0x1f, 0x8b, 0x08}
-	if bytes.Equal(byteData[0:3], magicGzip) {
-		r, err := gzip.NewReader(bytes.NewReader(byteData))
-		if err != nil {
-			return nil, err
-		}
-
-		defer r.Close()
-
-		uncompressedByteData, err := io.ReadAll(r)
-		if err != nil {
-			return nil, err
-		}
-
-		byteData = uncompressedByteData
-	}
-
-	storage := types.HelmReleaseStorage{}
-	if err := json.Unmarshal(byteData, &storage); err != nil {
-		return nil, fmt.Errorf("failed to decode the Helm storage object for HelmRelease '%s': %w", helmRelease.Name, err)
-	}
-
 	objects, err := ssa.ReadObjects(strings.NewReader(storage.Manifest))
 	if err != nil {
 		return nil, fmt.Errorf("failed to read the Helm storage object for HelmRelease '%s': %w", helmRelease.Name, err)
@@ -98,3 +71,40 @@ func getHelmReleaseInventory(ctx context.Context, helmRelease helmv2.HelmRelease
 
 	return gvk, nil
 }
+
+// decodeHelmReleaseStorage decodes the release kept by Helm in a storage secret.
+func decodeHelmReleaseStorage(storageSecret *v1.Secret, helmReleaseName string) (*types.HelmReleaseStorage, error) {
+	releaseData, releaseFound := storageSecret.Data["release"]
+	if !releaseFound {
+		return nil, fmt.Errorf("failed to decode the Helm storage object for HelmRelease '%s'", helmReleaseName)
+	}
+
+	byteData, err := base64.StdEncoding.DecodeString(string(releaseData))
+	if err != nil {
+		return nil, err
+	}
+
+	var magicGzip = []byte{0x1f, 0x8b, 0x08}
+	if bytes.HasPrefix(byteData, magicGzip) {
+		r, err := gzip.NewReader(bytes.NewReader(byteData))
+		if err != nil {
+			return nil, err
+		}
+
+		defer r.Close()
+
+		uncompressedByteData, err := io.ReadAll(r)
+		if err != nil {
+			return nil, err
+		}
+
+		byteData = uncompressedByteData
+	}
+
+	storage := &types.HelmReleaseStorage{}
+	if err := json.Unmarshal(byteData, storage); err != nil {
+		return nil, fmt.Errorf("failed to decode the Helm storage object for HelmRelease '%s': %w", helmReleaseName, err)
+	}
+
+	return storage, nil
+}
@@ -0,0 +1,143 @@
+package server
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/fluxsync"
+	"github.com/weaveworks/weave-gitops/core/server/types"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func (cs *coreServer) RollbackHelmRelease(ctx context.Context, msg *pb.RollbackHelmReleaseRequest) (*pb.RollbackHelmReleaseResponse, error) {
+	principal := auth.Principal(ctx)
+
+	err := cs.rollbackHelmRelease(ctx, principal, msg)
+
+	cs.recordAudit(ctx, audit.ActionRollback, principal, &pb.ObjectRef{
+		Kind:        helmv2.HelmReleaseKind,
+		Name:        msg.Name,
+		Namespace:   msg.Namespace,
+		ClusterName: msg.ClusterName,
+	}, err)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.RollbackHelmReleaseResponse{}, nil
+}
+
+func (cs *coreServer) rollbackHelmRelease(ctx context.Context, principal *auth.UserPrincipal, msg *pb.RollbackHelmReleaseRequest) error {
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		return fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(msg.ClusterName)
+	if err != nil {
+		return fmt.Errorf("getting cluster client: %w", err)
+	}
+
+	key := client.ObjectKey{Name: msg.Name, Namespace: msg.Namespace}
+	helmRelease := &helmv2.HelmRelease{}
+
+	if err := c.Get(ctx, key, helmRelease); err != nil {
+		return fmt.Errorf("getting helm release: %w", err)
+	}
+
+	// The chart version is ignored for charts from GitRepositories and
+	// Buckets, the chart is whatever is at the path in the source.
+	if kind := helmRelease.Spec.Chart.Spec.SourceRef.Kind; kind == sourcev1.GitRepositoryKind || kind == sourcev1.BucketKind {
+		return status.Errorf(codes.InvalidArgument, "helm releases with charts from a %s can't be rolled back", kind)
+	}
+
+	// Unlike for the history, the storage secrets are read with the user's
+	// permissions, as the values of the revision are written to the spec
+	storages, _, err := getHelmReleaseStorages(ctx, *helmRelease, clustersClient, msg.ClusterName)
+	if err != nil {
+		return err
+	}
+
+	var revision *types.HelmReleaseStorage
+
+	for _, storage := range storages {
+		if storage.Version == int(msg.Revision) {
+			revision = storage
+			break
+		}
+	}
+
+	if revision == nil {
+		return status.Errorf(codes.InvalidArgument, "revision %d is not in the release history", msg.Revision)
+	}
+
+	log := cs.logger.WithValues(
+		"user", principal.ID,
+		"kind", helmv2.HelmReleaseKind,
+		"name", key.Name,
+		"namespace", key.Namespace,
+		"revision", msg.Revision,
+	)
+
+	patch := client.MergeFrom(helmRelease.DeepCopy())
+
+	helmRelease.Spec.Chart.Spec.Version = revision.Chart.Metadata.Version
+
+	if len(helmRelease.Spec.ValuesFrom) == 0 {
+		values, err := helmValuesJSON(revision.Config)
+		if err != nil {
+			return fmt.Errorf("encoding values of revision %d: %w", revision.Version, err)
+		}
+
+		helmRelease.Spec.Values = values
+	} else if !reflect.DeepEqual(revision.Config, storages[0].Config) {
+		// The values Helm stored are merged with the ones from valuesFrom,
+		// which may be secrets that mustn't end up in the spec.
+		return status.Errorf(codes.FailedPrecondition,
+			"can't roll back to revision %d, its values differ from the current ones and include values from valuesFrom, which have to be rolled back by hand", msg.Revision)
+	}
+
+	log.Info("Rolling back resource")
+
+	if err := c.Patch(ctx, helmRelease, patch); err != nil {
+		return fmt.Errorf("patching helm release: %w", err)
+	}
+
+	obj := &fluxsync.HelmReleaseAdapter{HelmRelease: helmRelease}
+
+	if err := fluxsync.RequestReconciliation(ctx, c, key, obj.GroupVersionKind()); err != nil {
+		return fmt.Errorf("requesting reconciliation: %w", err)
+	}
+
+	if err := fluxsync.WaitForSync(ctx, c, key, obj); err != nil {
+		return fmt.Errorf("syncing helm release: %w", err)
+	}
+
+	return nil
+}
+
+// helmValuesJSON encodes the values Helm stored as the values of a
+// HelmRelease, with no values as none at all.
+func helmValuesJSON(values map[string]interface{}) (*apiextensionsv1.JSON, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+
+	raw, err := json.Marshal(values)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiextensionsv1.JSON{Raw: raw}, nil
+}
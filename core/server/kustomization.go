@@ -0,0 +1,106 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/go-logr/logr"
+	"github.com/hashicorp/go-multierror"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"sigs.k8s.io/cli-utils/pkg/object"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// inventoryConcurrency is how many inventory entries are fetched at once.
+const inventoryConcurrency = 10
+
+// getKustomizationInventory fetches the objects recorded in the Kustomization's
+// inventory that are of one of the given kinds, or of any kind if none are given.
+// Objects that have gone away, or that the user can't see, are skipped.
+func getKustomizationInventory(ctx context.Context, log logr.Logger, kustomization kustomizev1.Kustomization, kinds []*pb.GroupVersionKind, c clustersmngr.Client, cluster string) ([]unstructured.Unstructured, error) {
+	if kustomization.Status.Inventory == nil {
+		return nil, nil
+	}
+
+	var (
+		result   = []unstructured.Unstructured{}
+		resultMu = sync.Mutex{}
+
+		errs   = &multierror.Error{}
+		errsMu = sync.Mutex{}
+
+		wg = sync.WaitGroup{}
+		// Limits the concurrent requests, inventories can be large.
+		sem = make(chan struct{}, inventoryConcurrency)
+	)
+
+	for _, entry := range kustomization.Status.Inventory.Entries {
+		objMeta, err := object.ParseObjMetadata(entry.ID)
+		if err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("parsing inventory entry %q: %w", entry.ID, err))
+			continue
+		}
+
+		gvk := objMeta.GroupKind.WithVersion(entry.Version)
+		if !kindRequested(kinds, gvk.Group, gvk.Kind) {
+			continue
+		}
+
+		wg.Add(1)
+
+		sem <- struct{}{}
+
+		go func(objMeta object.ObjMetadata, entry kustomizev1.ResourceRef) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			obj := unstructured.Unstructured{}
+			obj.SetGroupVersionKind(objMeta.GroupKind.WithVersion(entry.Version))
+
+			key := client.ObjectKey{Name: objMeta.Name, Namespace: objMeta.Namespace}
+
+			if err := c.Get(ctx, cluster, key, &obj); err != nil {
+				if k8serrors.IsNotFound(err) || k8serrors.IsForbidden(err) {
+					log.V(logger.LogLevelDebug).Info("skipping inventory entry", "entry", entry.ID, "error", err)
+					return
+				}
+
+				errsMu.Lock()
+				errs = multierror.Append(errs, fmt.Errorf("getting inventory entry %q: %w", entry.ID, err))
+				errsMu.Unlock()
+
+				return
+			}
+
+			resultMu.Lock()
+			result = append(result, obj)
+			resultMu.Unlock()
+		}(objMeta, entry)
+	}
+
+	wg.Wait()
+
+	return result, errs.ErrorOrNil()
+}
+
+func kindRequested(kinds []*pb.GroupVersionKind, group, kind string) bool {
+	if len(kinds) == 0 {
+		return true
+	}
+
+	for _, k := range kinds {
+		if k.Group == group && k.Kind == kind {
+			return true
+		}
+	}
+
+	return false
+}
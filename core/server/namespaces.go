@@ -0,0 +1,70 @@
+package server
+
+import (
+	"context"
+	"sort"
+
+	"github.com/weaveworks/weave-gitops/core/logger"
+	"github.com/weaveworks/weave-gitops/core/server/types"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	corev1 "k8s.io/api/core/v1"
+)
+
+// ListNamespaces returns the namespaces the user can use on every cluster,
+// with the kinds of object they can read in each.
+func (cs *coreServer) ListNamespaces(ctx context.Context, msg *pb.ListNamespacesRequest) (*pb.ListNamespacesResponse, error) {
+	// This looks up the user's namespaces if they aren't cached. Clusters
+	// that can't be reached just don't have any namespaces.
+	if _, err := cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx)); err != nil {
+		cs.logger.V(logger.LogLevelDebug).Info("Couldn't get a client for every cluster", "error", err)
+	}
+
+	namespaces := []*pb.Namespace{}
+	accessibleKinds := cs.clustersManager.GetUserAccessibleKinds(auth.Principal(ctx))
+
+	for clusterName, clusterNamespaces := range cs.clustersManager.GetUserNamespaces(auth.Principal(ctx)) {
+		for _, ns := range clusterNamespaces {
+			namespaces = append(namespaces, types.NamespaceToProto(ns, clusterName, accessibleKinds[clusterName][ns.Name]))
+		}
+	}
+
+	sort.Slice(namespaces, func(i, j int) bool {
+		if namespaces[i].ClusterName != namespaces[j].ClusterName {
+			return namespaces[i].ClusterName < namespaces[j].ClusterName
+		}
+
+		return namespaces[i].Name < namespaces[j].Name
+	})
+
+	return &pb.ListNamespacesResponse{Namespaces: namespaces}, nil
+}
+
+// readableNamespaces returns the namespaces in which the user can read
+// objects of the kind, according to the namespace access rules.
+func readableNamespaces(namespaces map[string][]corev1.Namespace, accessibleKinds map[string]map[string][]string, kind string) map[string][]corev1.Namespace {
+	result := map[string][]corev1.Namespace{}
+
+	for clusterName, clusterNamespaces := range namespaces {
+		clusterKinds, restricted := accessibleKinds[clusterName]
+		if !restricted {
+			result[clusterName] = clusterNamespaces
+			continue
+		}
+
+		readable := []corev1.Namespace{}
+
+		for _, ns := range clusterNamespaces {
+			for _, k := range clusterKinds[ns.Name] {
+				if k == kind {
+					readable = append(readable, ns)
+					break
+				}
+			}
+		}
+
+		result[clusterName] = readable
+	}
+
+	return result
+}
@@ -72,6 +72,15 @@ func (cs *coreServer) ListObjects(ctx context.Context, msg *pb.ListObjectsReques
 		listOptions = append(listOptions, client.MatchingLabels(msg.Labels))
 	}
 
+	listOptions = append(listOptions, paginationListOptions(msg.Pagination)...)
+
+	clusterUserNamespaces := cs.clustersManager.GetUserNamespaces(auth.Principal(ctx))
+
+	// Don't list the kind in the namespaces where the namespace access rules
+	// say the user can't read it.
+	accessibleKinds := cs.clustersManager.GetUserAccessibleKinds(auth.Principal(ctx))
+	clustersClient = clustersmngr.NewClient(clustersClient.ClientsPool(), readableNamespaces(clustersClient.Namespaces(), accessibleKinds, gvk.Kind))
+
 	if err := clustersClient.ClusteredList(ctx, clist, true, listOptions...); err != nil {
 		var errs clustersmngr.ClusteredListError
 		if !errors.As(err, &errs) {
@@ -85,8 +94,6 @@ func (cs *coreServer) ListObjects(ctx context.Context, msg *pb.ListObjectsReques
 
 	var results []*pb.Object
 
-	clusterUserNamespaces := cs.clustersManager.GetUserNamespaces(auth.Principal(ctx))
-
 	for n, lists := range clist.Lists() {
 		for _, l := range lists {
 			list, ok := l.(*unstructured.UnstructuredList)
@@ -130,8 +137,9 @@ func (cs *coreServer) ListObjects(ctx context.Context, msg *pb.ListObjectsReques
 	}
 
 	return &pb.ListObjectsResponse{
-		Objects: results,
-		Errors:  respErrors,
+		Objects:   results,
+		Errors:    respErrors,
+		NextToken: nextPageToken(clist.GetContinue()),
 	}, nil
 }
 
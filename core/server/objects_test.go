@@ -12,13 +12,20 @@ import (
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/nsaccess"
 	"github.com/weaveworks/weave-gitops/core/server/types"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"google.golang.org/grpc/metadata"
 	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	k8sfake "k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
@@ -760,3 +767,201 @@ func TestListObjectsLabels(t *testing.T) {
 	g.Expect(err).NotTo(HaveOccurred())
 	g.Expect(data["metadata"].(map[string]interface{})["name"]).To(Equal(deployment1.Name))
 }
+
+func TestListObjectsPagination(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-namespace",
+		},
+	}
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		ns,
+		newDeployment("deployment-1", ns.Name, map[string]string{}),
+		newDeployment("deployment-2", ns.Name, map[string]string{}),
+	).Build()
+
+	cfg := makeServerConfig(fakeClient, t)
+	c := makeServer(cfg, t)
+
+	res, err := c.ListObjects(ctx, &pb.ListObjectsRequest{
+		Kind:       "Deployment",
+		Pagination: &pb.Pagination{PageSize: 10},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Objects).To(HaveLen(2))
+	g.Expect(res.NextToken).To(BeEmpty(), "expected no next token once everything has been listed")
+
+	_, err = c.ListObjects(ctx, &pb.ListObjectsRequest{
+		Kind:       "Deployment",
+		Pagination: &pb.Pagination{PageSize: 10, PageToken: "not-a-token"},
+	})
+	g.Expect(err).To(HaveOccurred())
+}
+
+func TestListObjectsPaginationContinuesFromTheToken(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-namespace",
+		},
+	}
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		ns,
+		newDeployment("deployment-1", ns.Name, map[string]string{}),
+		newDeployment("deployment-2", ns.Name, map[string]string{}),
+		newDeployment("deployment-3", ns.Name, map[string]string{}),
+	).Build()
+
+	cfg := makeServerConfig(pagingClient{fakeClient}, t)
+	c := makeServer(cfg, t)
+
+	res, err := c.ListObjects(ctx, &pb.ListObjectsRequest{
+		Kind:       "Deployment",
+		Pagination: &pb.Pagination{PageSize: 2},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Objects).To(HaveLen(2))
+	g.Expect(res.NextToken).NotTo(BeEmpty())
+
+	paginationInfo, err := clustersmngr.DecodePaginationInfo(res.NextToken)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(paginationInfo.Get("Default", ns.Name)).To(Equal("2"))
+
+	names := objectNames(g, res.Objects)
+
+	res, err = c.ListObjects(ctx, &pb.ListObjectsRequest{
+		Kind:       "Deployment",
+		Pagination: &pb.Pagination{PageSize: 2, PageToken: res.NextToken},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Objects).To(HaveLen(1))
+	g.Expect(res.NextToken).To(BeEmpty())
+
+	names = append(names, objectNames(g, res.Objects)...)
+	g.Expect(names).To(Equal([]string{"deployment-1", "deployment-2", "deployment-3"}))
+}
+
+func TestListObjectsPaginationRetriesNamespacesThatFailed(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ns := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-namespace",
+		},
+	}
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(
+		ns,
+		newDeployment("deployment-1", ns.Name, map[string]string{}),
+	).Build()
+
+	cfg := makeServerConfig(flakyClient{Client: fakeClient, failed: new(bool)}, t)
+	c := makeServer(cfg, t)
+
+	res, err := c.ListObjects(ctx, &pb.ListObjectsRequest{
+		Kind:       "Deployment",
+		Pagination: &pb.Pagination{PageSize: 2},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Objects).To(BeEmpty())
+	g.Expect(res.Errors).To(HaveLen(1))
+	g.Expect(res.NextToken).NotTo(BeEmpty())
+
+	res, err = c.ListObjects(ctx, &pb.ListObjectsRequest{
+		Kind:       "Deployment",
+		Pagination: &pb.Pagination{PageSize: 2, PageToken: res.NextToken},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Errors).To(BeEmpty())
+	g.Expect(objectNames(g, res.Objects)).To(Equal([]string{"deployment-1"}))
+	g.Expect(res.NextToken).To(BeEmpty())
+}
+
+func TestListObjectsSkipsNamespacesWhereTheKindCantBeRead(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	objects := []runtime.Object{}
+
+	for _, name := range []string{"apps", "secrets"} {
+		ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name}}
+		secret := &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "creds", Namespace: name}}
+		objects = append(objects, ns, secret)
+	}
+
+	// Listing anything in apps fails, so it mustn't be listed at all.
+	fakeClient := failingNamespaceClient{
+		Client:    fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(objects...).Build(),
+		namespace: "apps",
+	}
+
+	rules, err := nsaccess.ParseRules([]byte(`
+kinds:
+  Kustomization:
+  - apiGroups: ["kustomize.toolkit.fluxcd.io"]
+    resources: ["kustomizations"]
+    verbs: ["get", "list"]
+  Secret:
+  - apiGroups: [""]
+    resources: ["secrets"]
+    verbs: ["get", "list"]
+`))
+	g.Expect(err).NotTo(HaveOccurred())
+
+	userRules := map[string][]authorizationv1.ResourceRule{
+		"apps":    {{APIGroups: []string{"kustomize.toolkit.fluxcd.io"}, Resources: []string{"kustomizations"}, Verbs: []string{"get", "list"}}},
+		"secrets": {{APIGroups: []string{""}, Resources: []string{"secrets"}, Verbs: []string{"get", "list"}}},
+	}
+
+	clientset := k8sfake.NewSimpleClientset()
+	clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectRulesReview)
+		review.Status.ResourceRules = userRules[review.Spec.Namespace]
+
+		return true, review, nil
+	})
+
+	cfg := makeServerConfigWithChecker(fakeClient, clientset, nsaccess.NewRulesChecker(rules), t)
+	c := makeServer(cfg, t)
+
+	ctx := metadata.NewOutgoingContext(context.Background(), metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters"))
+
+	res, err := c.ListObjects(ctx, &pb.ListObjectsRequest{Kind: "Secret"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Errors).To(BeEmpty())
+	g.Expect(res.Objects).To(HaveLen(1))
+
+	var data map[string]interface{}
+	g.Expect(json.Unmarshal([]byte(res.Objects[0].Payload), &data)).To(Succeed())
+	g.Expect(data["metadata"].(map[string]interface{})["namespace"]).To(Equal("secrets"))
+
+	nsRes, err := c.ListNamespaces(ctx, &pb.ListNamespacesRequest{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(nsRes.Namespaces).To(HaveLen(2))
+	g.Expect(nsRes.Namespaces[0].Name).To(Equal("apps"))
+	g.Expect(nsRes.Namespaces[0].Kinds).To(Equal([]string{"Kustomization"}))
+	g.Expect(nsRes.Namespaces[1].Name).To(Equal("secrets"))
+	g.Expect(nsRes.Namespaces[1].Kinds).To(Equal([]string{"Secret"}))
+}
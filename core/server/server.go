@@ -3,9 +3,11 @@ package server
 import (
 	"context"
 	"fmt"
+	"net/http"
 
 	"github.com/go-logr/logr"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr"
 	"github.com/weaveworks/weave-gitops/core/nsaccess"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
@@ -23,6 +25,10 @@ func Hydrate(ctx context.Context, mux *runtime.ServeMux, cfg CoreServerConfig) e
 		return fmt.Errorf("could not register new app server: %w", err)
 	}
 
+	if err = mux.HandlePath(http.MethodGet, WatchObjectsPath, watchObjectsHandler(mux, appsServer)); err != nil {
+		return fmt.Errorf("could not register watch handler: %w", err)
+	}
+
 	return nil
 }
 
@@ -36,6 +42,7 @@ type coreServer struct {
 	clustersManager clustersmngr.ClustersManager
 	primaryKinds    *PrimaryKinds
 	crd             crd.Fetcher
+	auditSink       audit.AuditSink
 }
 
 type CoreServerConfig struct {
@@ -46,6 +53,7 @@ type CoreServerConfig struct {
 	ClustersManager clustersmngr.ClustersManager
 	PrimaryKinds    *PrimaryKinds
 	CRDService      crd.Fetcher
+	AuditSink       audit.AuditSink
 }
 
 func NewCoreConfig(log logr.Logger, cfg *rest.Config, clusterName string, clustersManager clustersmngr.ClustersManager) (CoreServerConfig, error) {
@@ -69,11 +77,16 @@ func NewCoreServer(cfg CoreServerConfig) (pb.CoreServer, error) {
 		cfg.CRDService = crd.NewFetcher(context.Background(), cfg.log, cfg.ClustersManager)
 	}
 
+	if cfg.AuditSink == nil {
+		cfg.AuditSink = audit.NoopSink{}
+	}
+
 	return &coreServer{
 		logger:          cfg.log,
 		nsChecker:       cfg.NSAccess,
 		clustersManager: cfg.ClustersManager,
 		primaryKinds:    cfg.PrimaryKinds,
 		crd:             cfg.CRDService,
+		auditSink:       cfg.AuditSink,
 	}, nil
 }
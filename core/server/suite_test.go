@@ -5,6 +5,8 @@ import (
 	"fmt"
 	"net"
 	"os"
+	"sort"
+	"strconv"
 	"testing"
 
 	"github.com/go-logr/logr"
@@ -12,6 +14,7 @@ import (
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster/clusterfakes"
 	"github.com/weaveworks/weave-gitops/core/clustersmngr/fetcher"
+	"github.com/weaveworks/weave-gitops/core/nsaccess"
 	"github.com/weaveworks/weave-gitops/core/nsaccess/nsaccessfakes"
 	"github.com/weaveworks/weave-gitops/core/server"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
@@ -23,10 +26,14 @@ import (
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/metadata"
 	"google.golang.org/grpc/test/bufconn"
+	authorizationv1 "k8s.io/api/authorization/v1"
 	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/fake"
 	typedauth "k8s.io/client-go/kubernetes/typed/authorization/v1"
 	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -89,6 +96,7 @@ func makeGRPCServer(cfg *rest.Config, t *testing.T) (pb.CoreClient, server.CoreS
 
 	s := grpc.NewServer(
 		withClientsPoolInterceptor(clustersManager),
+		withClientsPoolStreamInterceptor(clustersManager),
 	)
 
 	pb.RegisterCoreServer(s, core)
@@ -158,14 +166,73 @@ func withClientsPoolInterceptor(clustersManager clustersmngr.ClustersManager) gr
 	})
 }
 
+func withClientsPoolStreamInterceptor(clustersManager clustersmngr.ClustersManager) grpc.ServerOption {
+	return grpc.StreamInterceptor(func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx := ss.Context()
+
+		if err := clustersManager.UpdateClusters(ctx); err != nil {
+			return err
+		}
+		if err := clustersManager.UpdateNamespaces(ctx); err != nil {
+			return err
+		}
+
+		md, ok := metadata.FromIncomingContext(ctx)
+		if !ok {
+			return fmt.Errorf("getting metadata from context failed")
+		}
+
+		var user string
+		if len(md[MetadataUserKey]) > 0 {
+			user = md[MetadataUserKey][0]
+		}
+		groups := md[MetadataGroupsKey]
+		principal := auth.UserPrincipal{ID: user, Groups: groups}
+		clustersManager.UpdateUserNamespaces(ctx, &principal)
+
+		return handler(srv, &principalServerStream{ServerStream: ss, ctx: auth.WithPrincipal(ctx, &principal)})
+	})
+}
+
+type principalServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *principalServerStream) Context() context.Context {
+	return s.ctx
+}
+
 func makeServerConfig(fakeClient client.Client, t *testing.T) server.CoreServerConfig {
-	log := logr.Discard()
+	clientset := fake.NewSimpleClientset()
+	// Pretend the user has access to everything
+	clientset.PrependReactor("create", "selfsubjectaccessreviews", reviewReactor(true))
+
+	return makeServerConfigWithClientset(fakeClient, clientset, t)
+}
+
+// reviewReactor answers every SelfSubjectAccessReview with the same answer.
+func reviewReactor(allowed bool) k8stesting.ReactionFunc {
+	return func(action k8stesting.Action) (bool, runtime.Object, error) {
+		review := action.(k8stesting.CreateAction).GetObject().(*authorizationv1.SelfSubjectAccessReview)
+		review.Status.Allowed = allowed
+
+		return true, review, nil
+	}
+}
+
+func makeServerConfigWithClientset(fakeClient client.Client, clientset *fake.Clientset, t *testing.T) server.CoreServerConfig {
 	nsChecker = nsaccessfakes.FakeChecker{}
 	nsChecker.FilterAccessibleNamespacesStub = func(ctx context.Context, t typedauth.AuthorizationV1Interface, n []v1.Namespace) ([]v1.Namespace, error) {
 		// Pretend the user has access to everything
 		return n, nil
 	}
-	clientset := fake.NewSimpleClientset()
+
+	return makeServerConfigWithChecker(fakeClient, clientset, &nsChecker, t)
+}
+
+func makeServerConfigWithChecker(fakeClient client.Client, clientset *fake.Clientset, checker nsaccess.Checker, t *testing.T) server.CoreServerConfig {
+	log := logr.Discard()
 
 	cluster := clusterfakes.FakeCluster{}
 	cluster.GetNameReturns("Default")
@@ -177,14 +244,14 @@ func makeServerConfig(fakeClient client.Client, t *testing.T) server.CoreServerC
 
 	// Don't include the clustersmngr.DefaultKubeConfigOptions here as we're using a fake kubeclient
 	// and the default options include the Flowcontrol setup which is not mocked out
-	clustersManager := clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{fetcher}, &nsChecker, log)
+	clustersManager := clustersmngr.NewClustersManager([]clustersmngr.ClusterFetcher{fetcher}, checker, log)
 
 	coreCfg, err := server.NewCoreConfig(log, &rest.Config{}, "foobar", clustersManager)
 	if err != nil {
 		t.Fatal(err)
 	}
 
-	coreCfg.NSAccess = &nsChecker
+	coreCfg.NSAccess = checker
 
 	return coreCfg
 }
@@ -230,3 +297,95 @@ func makeServer(cfg server.CoreServerConfig, t *testing.T) pb.CoreClient {
 
 	return pb.NewCoreClient(conn)
 }
+
+// failingNamespaceClient fails to list anything in one namespace, as if it
+// timed out.
+type failingNamespaceClient struct {
+	client.Client
+	namespace string
+}
+
+func (c failingNamespaceClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	if listOpts.Namespace == c.namespace {
+		return fmt.Errorf("listing namespace %s timed out", c.namespace)
+	}
+
+	return c.Client.List(ctx, list, opts...)
+}
+
+// flakyClient fails the first time it's asked to list anything in a
+// namespace, as if it timed out.
+type flakyClient struct {
+	client.Client
+	failed *bool
+}
+
+func (c flakyClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	if listOpts.Namespace != "" && !*c.failed {
+		*c.failed = true
+		return fmt.Errorf("listing namespace %s timed out", listOpts.Namespace)
+	}
+
+	return c.Client.List(ctx, list, opts...)
+}
+
+// pagingClient serves lists a page at a time, with a continue token for the
+// next page, the way the API server does. The fake client ignores limits and
+// continue tokens.
+type pagingClient struct {
+	client.Client
+}
+
+func (c pagingClient) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if err := c.Client.List(ctx, list, opts...); err != nil {
+		return err
+	}
+
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	if listOpts.Limit == 0 {
+		return nil
+	}
+
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return err
+	}
+
+	// The fake client doesn't list in a stable order
+	sort.Slice(items, func(i, j int) bool {
+		return client.ObjectKeyFromObject(items[i].(client.Object)).String() < client.ObjectKeyFromObject(items[j].(client.Object)).String()
+	})
+
+	start := 0
+
+	if listOpts.Continue != "" {
+		start, err = strconv.Atoi(listOpts.Continue)
+		if err != nil {
+			return fmt.Errorf("invalid continue token %q: %w", listOpts.Continue, err)
+		}
+	}
+
+	end := start + int(listOpts.Limit)
+	continueToken := strconv.Itoa(end)
+
+	if end >= len(items) {
+		end = len(items)
+		continueToken = ""
+	}
+
+	if err := meta.SetList(list, items[start:end]); err != nil {
+		return err
+	}
+
+	list.SetContinue(continueToken)
+
+	return nil
+}
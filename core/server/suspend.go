@@ -5,6 +5,7 @@ import (
 	"fmt"
 
 	"github.com/hashicorp/go-multierror"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/fluxsync"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
@@ -15,58 +16,75 @@ func (cs *coreServer) ToggleSuspendResource(ctx context.Context, msg *pb.ToggleS
 	principal := auth.Principal(ctx)
 	respErrors := multierror.Error{}
 
+	action := audit.ActionResume
+	if msg.Suspend {
+		action = audit.ActionSuspend
+	}
+
 	for _, obj := range msg.Objects {
-		clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, auth.Principal(ctx))
-		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("error getting impersonating client: %w", err), respErrors.Errors...)
-			continue
-		}
+		err := cs.toggleSuspendResource(ctx, principal, obj, msg.Suspend)
+
+		cs.recordAudit(ctx, action, principal, obj, err)
 
-		c, err := clustersClient.Scoped(obj.ClusterName)
 		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("getting cluster client: %w", err), respErrors.Errors...)
-			continue
+			respErrors = *multierror.Append(err, respErrors.Errors...)
 		}
+	}
 
-		key := client.ObjectKey{
-			Name:      obj.Name,
-			Namespace: obj.Namespace,
-		}
+	return &pb.ToggleSuspendResourceResponse{}, respErrors.ErrorOrNil()
+}
 
-		obj, err := getReconcilableObject(obj.Kind)
-		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("converting to reconcilable source: %w", err), respErrors.Errors...)
-			continue
-		}
+func (cs *coreServer) toggleSuspendResource(ctx context.Context, principal *auth.UserPrincipal, ref *pb.ObjectRef, suspend bool) error {
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		return fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(ref.ClusterName)
+	if err != nil {
+		return fmt.Errorf("getting cluster client: %w", err)
+	}
 
-		log := cs.logger.WithValues(
-			"user", principal.ID,
-			"kind", obj.GroupVersionKind().Kind,
-			"name", key.Name,
-			"namespace", key.Namespace,
-		)
+	key := client.ObjectKey{
+		Name:      ref.Name,
+		Namespace: ref.Namespace,
+	}
 
-		if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("getting reconcilable object: %w", err), respErrors.Errors...)
-			continue
-		}
+	obj, err := getReconcilableObject(ref.Kind)
+	if err != nil {
+		return fmt.Errorf("converting to reconcilable source: %w", err)
+	}
 
-		patch := client.MergeFrom(obj.DeepCopyClientObject())
+	if !fluxsync.IsSuspendable(obj) {
+		return fmt.Errorf("%s objects can't be suspended or resumed", ref.Kind)
+	}
 
-		obj.SetSuspended(msg.Suspend)
+	log := cs.logger.WithValues(
+		"user", principal.ID,
+		"kind", obj.GroupVersionKind().Kind,
+		"name", key.Name,
+		"namespace", key.Namespace,
+	)
 
-		if msg.Suspend {
-			log.Info("Suspending resource")
-		} else {
-			log.Info("Resuming resource")
-		}
+	if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
+		return fmt.Errorf("getting reconcilable object: %w", err)
+	}
 
-		if err := c.Patch(ctx, obj.AsClientObject(), patch); err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("patching object: %w", err), respErrors.Errors...)
-		}
+	patch := client.MergeFrom(obj.DeepCopyClientObject())
+
+	obj.SetSuspended(suspend)
+
+	if suspend {
+		log.Info("Suspending resource")
+	} else {
+		log.Info("Resuming resource")
 	}
 
-	return &pb.ToggleSuspendResourceResponse{}, respErrors.ErrorOrNil()
+	if err := c.Patch(ctx, obj.AsClientObject(), patch); err != nil {
+		return fmt.Errorf("patching object: %w", err)
+	}
+
+	return nil
 }
 
 func getReconcilableObject(kind string) (fluxsync.Reconcilable, error) {
@@ -5,14 +5,19 @@ import (
 	"testing"
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta1"
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/audit/auditfakes"
 	api "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"google.golang.org/grpc/metadata"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestSuspend_Suspend(t *testing.T) {
@@ -168,3 +173,90 @@ func checkSpec(t *testing.T, k client.Client, name types.NamespacedName, obj cli
 func TestSuspend_Resume(t *testing.T) {
 
 }
+
+func TestSuspend_RecordsAudit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	kust := &kustomizev1.Kustomization{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "kust-1",
+			Namespace: "test-namespace",
+		},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(kust).Build()
+	sink := &auditfakes.FakeAuditSink{}
+
+	cfg := makeServerConfig(fakeClient, t)
+	cfg.AuditSink = sink
+	c := makeServer(cfg, t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	_, err = c.ToggleSuspendResource(outgoingCtx, &api.ToggleSuspendResourceRequest{
+		Objects: []*api.ObjectRef{
+			{Kind: kustomizev1.KustomizationKind, Name: "kust-1", Namespace: "test-namespace", ClusterName: "Default"},
+			{Kind: kustomizev1.KustomizationKind, Name: "missing", Namespace: "test-namespace", ClusterName: "Default"},
+		},
+		Suspend: true,
+	})
+	g.Expect(err).To(HaveOccurred())
+
+	g.Expect(sink.RecordCallCount()).To(Equal(2))
+
+	_, event := sink.RecordArgsForCall(0)
+	g.Expect(event.Action).To(Equal(audit.ActionSuspend))
+	g.Expect(event.Principal).To(Equal("anne"))
+	g.Expect(event.Cluster).To(Equal("Default"))
+	g.Expect(event.Object).To(Equal(&audit.ObjectRef{APIVersion: kustomizev1.GroupVersion.String(), Kind: kustomizev1.KustomizationKind, Name: "kust-1", Namespace: "test-namespace"}))
+	g.Expect(event.Outcome).To(Equal(audit.OutcomeSuccess))
+
+	_, event = sink.RecordArgsForCall(1)
+	g.Expect(event.Object.Name).To(Equal("missing"))
+	g.Expect(event.Outcome).To(Equal(audit.OutcomeFailure))
+	g.Expect(event.Error).NotTo(BeEmpty())
+}
+
+func TestSuspend_ImagePolicy(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	repo := &reflectorv1.ImageRepository{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-repo", Namespace: "test-namespace"},
+	}
+	policy := &reflectorv1.ImagePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-policy", Namespace: "test-namespace"},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(repo, policy).Build()
+	c := makeServer(makeServerConfig(fakeClient, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	_, err = c.ToggleSuspendResource(outgoingCtx, &api.ToggleSuspendResourceRequest{
+		Objects: []*api.ObjectRef{{Kind: reflectorv1.ImageRepositoryKind, Name: "my-repo", Namespace: "test-namespace", ClusterName: "Default"}},
+		Suspend: true,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	updated := &reflectorv1.ImageRepository{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(repo), updated)).To(Succeed())
+	g.Expect(updated.Spec.Suspend).To(BeTrue())
+
+	_, err = c.ToggleSuspendResource(outgoingCtx, &api.ToggleSuspendResourceRequest{
+		Objects: []*api.ObjectRef{{Kind: reflectorv1.ImagePolicyKind, Name: "my-policy", Namespace: "test-namespace", ClusterName: "Default"}},
+		Suspend: true,
+	})
+	g.Expect(err).To(MatchError(ContainSubstring("can't be suspended")))
+}
@@ -5,9 +5,13 @@ import (
 	"fmt"
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	imageautomationv1 "github.com/fluxcd/image-automation-controller/api/v1beta1"
+	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta1"
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/hashicorp/go-multierror"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/core/fluxsync"
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
@@ -19,102 +23,105 @@ func (cs *coreServer) SyncFluxObject(ctx context.Context, msg *pb.SyncFluxObject
 	respErrors := multierror.Error{}
 
 	for _, sync := range msg.Objects {
-		clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
-		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("error getting impersonating client: %w", err), respErrors.Errors...)
-			continue
-		}
+		err := cs.syncFluxObject(ctx, principal, sync, msg.WithSource)
+
+		cs.recordAudit(ctx, audit.ActionSync, principal, sync, err)
 
-		c, err := clustersClient.Scoped(sync.ClusterName)
 		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("getting cluster client: %w", err), respErrors.Errors...)
-			continue
+			respErrors = *multierror.Append(err, respErrors.Errors...)
 		}
+	}
 
-		key := client.ObjectKey{
-			Name:      sync.Name,
-			Namespace: sync.Namespace,
-		}
+	return &pb.SyncFluxObjectResponse{}, respErrors.ErrorOrNil()
+}
+
+func (cs *coreServer) syncFluxObject(ctx context.Context, principal *auth.UserPrincipal, sync *pb.ObjectRef, withSource bool) error {
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		return fmt.Errorf("error getting impersonating client: %w", err)
+	}
+
+	c, err := clustersClient.Scoped(sync.ClusterName)
+	if err != nil {
+		return fmt.Errorf("getting cluster client: %w", err)
+	}
+
+	key := client.ObjectKey{
+		Name:      sync.Name,
+		Namespace: sync.Namespace,
+	}
+
+	obj, err := getFluxObject(sync.Kind)
+	if err != nil {
+		return fmt.Errorf("error converting to object: %w", err)
+	}
+
+	if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
+		return fmt.Errorf("error getting object: %w", err)
+	}
+
+	automation, isAutomation := obj.(fluxsync.Automation)
+	if withSource && isAutomation {
+		sourceRef := automation.SourceRef()
+
+		_, sourceObj, err := fluxsync.ToReconcileable(sourceRef.Kind())
 
-		obj, err := getFluxObject(sync.Kind)
 		if err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("error converting to object: %w", err), respErrors.Errors...)
-			continue
+			return fmt.Errorf("getting source type for %q: %w", sourceRef.Kind(), err)
 		}
 
-		if err := c.Get(ctx, key, obj.AsClientObject()); err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("error getting object: %w", err), respErrors.Errors...)
-			continue
+		sourceNs := sourceRef.Namespace()
+
+		// sourceRef.Namespace is an optional field in flux
+		// From the flux type reference:
+		// "Namespace of the referent, defaults to the namespace of the Kubernetes resource object that contains the reference."
+		// https://github.com/fluxcd/kustomize-controller/blob/4da17e1ffb9c2b9e057ff3440f66500394a4f765/api/v1beta2/reference_types.go#L37
+		if sourceNs == "" {
+			sourceNs = sync.Namespace
 		}
 
-		automation, isAutomation := obj.(fluxsync.Automation)
-		if msg.WithSource && isAutomation {
-			sourceRef := automation.SourceRef()
-
-			_, sourceObj, err := fluxsync.ToReconcileable(sourceRef.Kind())
-
-			if err != nil {
-				respErrors = *multierror.Append(fmt.Errorf("getting source type for %q: %w", sourceRef.Kind(), err), respErrors.Errors...)
-				continue
-			}
-
-			sourceNs := sourceRef.Namespace()
-
-			// sourceRef.Namespace is an optional field in flux
-			// From the flux type reference:
-			// "Namespace of the referent, defaults to the namespace of the Kubernetes resource object that contains the reference."
-			// https://github.com/fluxcd/kustomize-controller/blob/4da17e1ffb9c2b9e057ff3440f66500394a4f765/api/v1beta2/reference_types.go#L37
-			if sourceNs == "" {
-				sourceNs = sync.Namespace
-			}
-
-			sourceKey := client.ObjectKey{
-				Name:      sourceRef.Name(),
-				Namespace: sourceNs,
-			}
-
-			sourceGvk := sourceObj.GroupVersionKind()
-
-			log := cs.logger.WithValues(
-				"user", principal.ID,
-				"kind", sourceRef.Kind(),
-				"name", sourceRef.Name(),
-				"namespace", sourceNs,
-			)
-			log.Info("Syncing resource")
-
-			if err := fluxsync.RequestReconciliation(ctx, c, sourceKey, sourceGvk); err != nil {
-				respErrors = *multierror.Append(fmt.Errorf("requesting source reconciliation: %w", err), respErrors.Errors...)
-				continue
-			}
-
-			if err := fluxsync.WaitForSync(ctx, c, sourceKey, sourceObj); err != nil {
-				respErrors = *multierror.Append(fmt.Errorf("syncing source: %w", err), respErrors.Errors...)
-				continue
-			}
+		sourceKey := client.ObjectKey{
+			Name:      sourceRef.Name(),
+			Namespace: sourceNs,
 		}
 
+		sourceGvk := sourceObj.GroupVersionKind()
+
 		log := cs.logger.WithValues(
 			"user", principal.ID,
-			"kind", obj.GroupVersionKind().Kind,
-			"name", key.Name,
-			"namespace", key.Namespace,
+			"kind", sourceRef.Kind(),
+			"name", sourceRef.Name(),
+			"namespace", sourceNs,
 		)
 		log.Info("Syncing resource")
 
-		gvk := obj.GroupVersionKind()
-		if err := fluxsync.RequestReconciliation(ctx, c, key, gvk); err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("requesting reconciliation: %w", err), respErrors.Errors...)
-			continue
+		if err := fluxsync.RequestReconciliation(ctx, c, sourceKey, sourceGvk); err != nil {
+			return fmt.Errorf("requesting source reconciliation: %w", err)
 		}
 
-		if err := fluxsync.WaitForSync(ctx, c, key, obj); err != nil {
-			respErrors = *multierror.Append(fmt.Errorf("syncing automation: %w", err), respErrors.Errors...)
-			continue
+		if err := fluxsync.WaitForSync(ctx, c, sourceKey, sourceObj); err != nil {
+			return fmt.Errorf("syncing source: %w", err)
 		}
 	}
 
-	return &pb.SyncFluxObjectResponse{}, respErrors.ErrorOrNil()
+	log := cs.logger.WithValues(
+		"user", principal.ID,
+		"kind", obj.GroupVersionKind().Kind,
+		"name", key.Name,
+		"namespace", key.Namespace,
+	)
+	log.Info("Syncing resource")
+
+	gvk := obj.GroupVersionKind()
+	if err := fluxsync.RequestReconciliation(ctx, c, key, gvk); err != nil {
+		return fmt.Errorf("requesting reconciliation: %w", err)
+	}
+
+	if err := fluxsync.WaitForSync(ctx, c, key, obj); err != nil {
+		return fmt.Errorf("syncing automation: %w", err)
+	}
+
+	return nil
 }
 
 func getFluxObject(kind string) (fluxsync.Reconcilable, error) {
@@ -132,6 +139,18 @@ func getFluxObject(kind string) (fluxsync.Reconcilable, error) {
 		return &fluxsync.HelmRepositoryAdapter{HelmRepository: &sourcev1.HelmRepository{}}, nil
 	case sourcev1.OCIRepositoryKind:
 		return &fluxsync.OCIRepositoryAdapter{OCIRepository: &sourcev1.OCIRepository{}}, nil
+
+	case reflectorv1.ImageRepositoryKind:
+		return &fluxsync.ImageRepositoryAdapter{ImageRepository: &reflectorv1.ImageRepository{}}, nil
+	case reflectorv1.ImagePolicyKind:
+		return &fluxsync.ImagePolicyAdapter{ImagePolicy: &reflectorv1.ImagePolicy{}}, nil
+	case imageautomationv1.ImageUpdateAutomationKind:
+		return &fluxsync.ImageUpdateAutomationAdapter{ImageUpdateAutomation: &imageautomationv1.ImageUpdateAutomation{}}, nil
+
+	case notificationv1.ProviderKind:
+		return &fluxsync.ProviderAdapter{Provider: &notificationv1.Provider{}}, nil
+	case notificationv1.AlertKind:
+		return &fluxsync.AlertAdapter{Alert: &notificationv1.Alert{}}, nil
 	}
 
 	return nil, fmt.Errorf("not supported kind: %s", kind)
@@ -7,7 +7,10 @@ import (
 	"time"
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	imageautomationv1 "github.com/fluxcd/image-automation-controller/api/v1beta1"
+	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta1"
 	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
 	"github.com/fluxcd/pkg/apis/meta"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	. "github.com/onsi/gomega"
@@ -20,6 +23,7 @@ import (
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"github.com/weaveworks/weave-gitops/pkg/kube"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 func TestSync(t *testing.T) {
@@ -159,6 +163,83 @@ func TestSync(t *testing.T) {
 	}
 }
 
+func TestSync_NotificationKinds(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx := context.Background()
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	ready := []metav1.Condition{{Type: meta.ReadyCondition, Status: metav1.ConditionTrue, Reason: meta.SucceededReason}}
+
+	alert := &notificationv1.Alert{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-alert", Namespace: "test-namespace"},
+		Status:     notificationv1.AlertStatus{Conditions: ready},
+	}
+	provider := &notificationv1.Provider{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-provider", Namespace: "test-namespace"},
+		Status:     notificationv1.ProviderStatus{Conditions: ready},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithRuntimeObjects(alert, provider).Build()
+	c := makeServer(makeServerConfig(fakeClient, t), t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	// Neither kind records the reconcile requests it has handled, so syncing
+	// them must not wait for a status update that never comes.
+	_, err = c.SyncFluxObject(outgoingCtx, &pb.SyncFluxObjectRequest{
+		Objects: []*pb.ObjectRef{
+			{Kind: notificationv1.AlertKind, Name: "my-alert", Namespace: "test-namespace", ClusterName: "Default"},
+			{Kind: notificationv1.ProviderKind, Name: "my-provider", Namespace: "test-namespace", ClusterName: "Default"},
+		},
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	updated := &notificationv1.Alert{}
+	g.Expect(fakeClient.Get(ctx, client.ObjectKeyFromObject(alert), updated)).To(Succeed())
+	g.Expect(updated.GetAnnotations()).To(HaveKey(meta.ReconcileRequestAnnotation))
+}
+
+func TestSync_ImageAutomationAdapters(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ns := corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "test-namespace"}}
+
+	policy := &fluxsync.ImagePolicyAdapter{ImagePolicy: &reflectorv1.ImagePolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "my-policy", Namespace: ns.Name},
+		Spec: reflectorv1.ImagePolicySpec{
+			ImageRepositoryRef: meta.NamespacedObjectReference{Name: "my-repo"},
+		},
+	}}
+	g.Expect(policy.SourceRef().Kind()).To(Equal(reflectorv1.ImageRepositoryKind))
+	g.Expect(policy.SourceRef().Name()).To(Equal("my-repo"))
+	g.Expect(fluxsync.IsSuspendable(policy)).To(BeFalse())
+
+	automation := &fluxsync.ImageUpdateAutomationAdapter{ImageUpdateAutomation: &imageautomationv1.ImageUpdateAutomation{
+		Spec: imageautomationv1.ImageUpdateAutomationSpec{
+			SourceRef: imageautomationv1.CrossNamespaceSourceReference{Kind: sourcev1.GitRepositoryKind, Name: "my-git", Namespace: "flux-system"},
+		},
+	}}
+	g.Expect(automation.SourceRef().Kind()).To(Equal(sourcev1.GitRepositoryKind))
+	g.Expect(automation.SourceRef().Namespace()).To(Equal("flux-system"))
+	g.Expect(fluxsync.IsSuspendable(automation)).To(BeTrue())
+
+	for _, kind := range []string{
+		reflectorv1.ImageRepositoryKind,
+		reflectorv1.ImagePolicyKind,
+		imageautomationv1.ImageUpdateAutomationKind,
+		notificationv1.ProviderKind,
+		notificationv1.AlertKind,
+	} {
+		_, obj, err := fluxsync.ToReconcileable(kind)
+		g.Expect(err).NotTo(HaveOccurred())
+		g.Expect(obj.GroupVersionKind().Kind).To(Equal(kind))
+	}
+}
+
 func simulateReconcile(ctx context.Context, k client.Client, name types.NamespacedName, o client.Object) error {
 	switch obj := o.(type) {
 	case *sourcev1.GitRepository:
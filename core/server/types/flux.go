@@ -0,0 +1,42 @@
+package types
+
+import (
+	"os"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+// FluxNamespacePartOf is the value of the PartOfLabel on the namespaces Flux
+// is installed in, and on its controllers.
+const FluxNamespacePartOf = "flux"
+
+// DefaultFluxNamespace is where Flux is assumed to be installed when its
+// namespace isn't labelled.
+var DefaultFluxNamespace = lookupEnv("WEAVE_GITOPS_FALLBACK_NAMESPACE", "flux-system")
+
+// FilterFluxNamespaces returns the namespaces Flux is installed in: those
+// labelled as part of Flux, and the fallback Flux namespace.
+func FilterFluxNamespaces(nss []corev1.Namespace) []corev1.Namespace {
+	fluxSystem := []corev1.Namespace{}
+
+	for _, ns := range nss {
+		if val, ok := ns.Labels[PartOfLabel]; ok && val == FluxNamespacePartOf {
+			fluxSystem = append(fluxSystem, ns)
+			continue
+		}
+
+		if ns.Name == DefaultFluxNamespace {
+			fluxSystem = append(fluxSystem, ns)
+		}
+	}
+
+	return fluxSystem
+}
+
+func lookupEnv(envVar string, fallback string) string {
+	if val, ok := os.LookupEnv(envVar); ok {
+		return val
+	}
+
+	return fallback
+}
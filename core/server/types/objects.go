@@ -2,15 +2,39 @@ package types
 
 import (
 	"bytes"
+	"time"
 
 	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	"k8s.io/apimachinery/pkg/runtime/serializer/json"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// HelmReleaseStorage is the subset of a release, as Helm keeps it in its
+// storage secrets, that we're interested in.
 type HelmReleaseStorage struct {
-	Name     string `json:"name,omitempty"`
-	Manifest string `json:"manifest,omitempty"`
+	Name     string                 `json:"name,omitempty"`
+	Manifest string                 `json:"manifest,omitempty"`
+	Version  int                    `json:"version,omitempty"`
+	Info     HelmReleaseInfo        `json:"info,omitempty"`
+	Chart    HelmReleaseChart       `json:"chart,omitempty"`
+	Config   map[string]interface{} `json:"config,omitempty"`
+}
+
+type HelmReleaseInfo struct {
+	LastDeployed time.Time `json:"last_deployed,omitempty"`
+	Description  string    `json:"description,omitempty"`
+	Status       string    `json:"status,omitempty"`
+}
+
+type HelmReleaseChart struct {
+	Metadata HelmChartMetadata      `json:"metadata,omitempty"`
+	Values   map[string]interface{} `json:"values,omitempty"`
+}
+
+type HelmChartMetadata struct {
+	Name       string `json:"name,omitempty"`
+	Version    string `json:"version,omitempty"`
+	AppVersion string `json:"appVersion,omitempty"`
 }
 
 func K8sObjectToProto(object client.Object, clusterName string, tenant string, inventory []*pb.GroupVersionKind) (*pb.Object, error) {
@@ -1,6 +1,8 @@
 package server
 
 import (
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
 	v1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -18,3 +20,36 @@ func GetTenant(namespace, clusterName string, clusterUserNamespaces map[string][
 
 	return ""
 }
+
+// paginationListOptions returns the list options needed to request a single
+// page from ClusteredList.
+func paginationListOptions(pagination *pb.Pagination) []client.ListOption {
+	if pagination == nil {
+		return nil
+	}
+
+	opts := []client.ListOption{
+		client.Continue(pagination.PageToken),
+	}
+
+	if pagination.PageSize > 0 {
+		opts = append(opts, client.Limit(int64(pagination.PageSize)))
+	}
+
+	return opts
+}
+
+// nextPageToken returns the continue token to hand back to the caller, which
+// is empty once every cluster and namespace has been fully listed.
+func nextPageToken(continueToken string) string {
+	if continueToken == "" {
+		return ""
+	}
+
+	paginationInfo, err := clustersmngr.DecodePaginationInfo(continueToken)
+	if err != nil || !paginationInfo.HasMore() {
+		return ""
+	}
+
+	return continueToken
+}
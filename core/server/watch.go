@@ -0,0 +1,265 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/fluxcd/helm-controller/api/v2beta1"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr"
+	"github.com/weaveworks/weave-gitops/core/clustersmngr/cluster"
+	"github.com/weaveworks/weave-gitops/core/logger"
+	"github.com/weaveworks/weave-gitops/core/server/types"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	v1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// How long to wait before re-establishing a watch the API server closed
+	watchRestartInterval = 2 * time.Second
+)
+
+type watchEvent struct {
+	clusterName string
+	namespace   string
+	event       watch.Event
+	// err is set instead of event when the namespace couldn't be watched
+	err error
+}
+
+// WatchObjects streams events for the requested kind from every cluster and
+// namespace the user has access to, until the client goes away.
+func (cs *coreServer) WatchObjects(msg *pb.WatchObjectsRequest, stream pb.Core_WatchObjectsServer) error {
+	ctx := stream.Context()
+	principal := auth.Principal(ctx)
+
+	if principal == nil || !principal.Valid() {
+		return status.Error(codes.Unauthenticated, "no user found in request")
+	}
+
+	gvk, err := cs.primaryKinds.Lookup(msg.Kind)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	clustersClient, err := cs.clustersManager.GetImpersonatedClient(ctx, principal)
+	if err != nil {
+		cs.logger.Error(err, "some clusters could not be reached, watching the rest")
+	}
+
+	clusterUserNamespaces := cs.clustersManager.GetUserNamespaces(principal)
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	events := make(chan watchEvent)
+	wg := sync.WaitGroup{}
+	watched := 0
+
+	for _, cl := range cs.clustersManager.GetClusters() {
+		if msg.ClusterName != "" && cl.GetName() != msg.ClusterName {
+			continue
+		}
+
+		watchClient, err := newUserWatchClient(cl, principal)
+		if err != nil {
+			cs.logger.Error(err, "failed creating watch client", "cluster", cl.GetName())
+			continue
+		}
+
+		for _, ns := range watchNamespaces(msg.Namespace, clusterUserNamespaces[cl.GetName()]) {
+			watched++
+
+			wg.Add(1)
+
+			go func(clusterName, namespace string) {
+				defer wg.Done()
+
+				cs.watchNamespace(ctx, watchClient, *gvk, clusterName, namespace, msg.Labels, events)
+			}(cl.GetName(), ns)
+		}
+	}
+
+	if watched == 0 {
+		return status.Error(codes.NotFound, "no namespaces the user can access to watch")
+	}
+
+	go func() {
+		wg.Wait()
+		close(events)
+	}()
+
+	for ev := range events {
+		if ev.err != nil {
+			if err := stream.Send(watchErrorToProto(ev)); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		res, err := cs.watchEventToProto(ctx, ev, clustersClient, clusterUserNamespaces)
+		if err != nil {
+			cs.logger.V(logger.LogLevelDebug).Info("Couldn't convert watched object", "error", err, "cluster", ev.clusterName)
+			continue
+		}
+
+		if err := stream.Send(res); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// watchNamespace keeps a watch open on a single namespace of a cluster,
+// re-establishing it when the API server closes it, until ctx is done. Failures
+// to watch are sent as events too, so the client knows what it's missing.
+func (cs *coreServer) watchNamespace(ctx context.Context, c client.WithWatch, gvk schema.GroupVersionKind, clusterName, namespace string, labels map[string]string, events chan<- watchEvent) {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gvk)
+
+	opts := []client.ListOption{client.InNamespace(namespace)}
+	if len(labels) > 0 {
+		opts = append(opts, client.MatchingLabels(labels))
+	}
+
+	send := func(ev watchEvent) bool {
+		select {
+		case events <- ev:
+			return true
+		case <-ctx.Done():
+			return false
+		}
+	}
+
+	for {
+		w, err := c.Watch(ctx, list, opts...)
+		if err != nil {
+			cs.logger.Error(err, "failed watching objects", "cluster", clusterName, "namespace", namespace, "kind", gvk.Kind)
+
+			if ctx.Err() == nil && !send(watchEvent{clusterName: clusterName, namespace: namespace, err: err}) {
+				return
+			}
+		} else {
+			for ev := range w.ResultChan() {
+				if ev.Type == watch.Bookmark {
+					continue
+				}
+
+				next := watchEvent{clusterName: clusterName, namespace: namespace, event: ev}
+				if ev.Type == watch.Error {
+					next = watchEvent{clusterName: clusterName, namespace: namespace, err: apierrors.FromObject(ev.Object)}
+				}
+
+				if !send(next) {
+					w.Stop()
+					return
+				}
+			}
+
+			w.Stop()
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(watchRestartInterval):
+		}
+	}
+}
+
+func (cs *coreServer) watchEventToProto(ctx context.Context, ev watchEvent, clustersClient clustersmngr.Client, clusterUserNamespaces map[string][]v1.Namespace) (*pb.WatchObjectsResponse, error) {
+	unstructuredObj, ok := ev.event.Object.(*unstructured.Unstructured)
+	if !ok {
+		return nil, fmt.Errorf("unexpected object type %T", ev.event.Object)
+	}
+
+	var (
+		obj       client.Object = unstructuredObj
+		inventory []*pb.GroupVersionKind
+		err       error
+	)
+
+	switch unstructuredObj.GetKind() {
+	case "Secret":
+		obj, err = sanitizeSecret(unstructuredObj)
+		if err != nil {
+			return nil, fmt.Errorf("error sanitizing secrets: %w", err)
+		}
+	case v2beta1.HelmReleaseKind:
+		if clustersClient != nil && ev.event.Type != watch.Deleted {
+			inventory, err = getUnstructuredHelmReleaseInventory(ctx, *unstructuredObj, clustersClient, ev.clusterName)
+			if err != nil {
+				inventory = nil // We can still display most things without inventory
+
+				cs.logger.V(logger.LogLevelDebug).Info("Couldn't grab inventory for helm release", "error", err)
+			}
+		}
+	}
+
+	tenant := GetTenant(obj.GetNamespace(), ev.clusterName, clusterUserNamespaces)
+
+	o, err := types.K8sObjectToProto(obj, ev.clusterName, tenant, inventory)
+	if err != nil {
+		return nil, fmt.Errorf("converting object to proto: %w", err)
+	}
+
+	return &pb.WatchObjectsResponse{
+		Type:   string(ev.event.Type),
+		Object: o,
+	}, nil
+}
+
+func watchErrorToProto(ev watchEvent) *pb.WatchObjectsResponse {
+	return &pb.WatchObjectsResponse{
+		Type: string(watch.Error),
+		Error: &pb.ListError{
+			ClusterName: ev.clusterName,
+			Namespace:   ev.namespace,
+			Message:     ev.err.Error(),
+		},
+	}
+}
+
+// watchNamespaces returns the namespaces to open watches on, which is either
+// the requested namespace, if the user can access it, or all the accessible ones.
+func watchNamespaces(requested string, accessible []v1.Namespace) []string {
+	namespaces := []string{}
+
+	for _, ns := range accessible {
+		if requested == "" || requested == ns.Name {
+			namespaces = append(namespaces, ns.Name)
+		}
+	}
+
+	return namespaces
+}
+
+func newUserWatchClient(cl cluster.Cluster, principal *auth.UserPrincipal) (client.WithWatch, error) {
+	serverConfig, err := cl.GetServerConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	cfg := kube.ConfigWithPrincipal(principal, serverConfig)
+	// Watches are long-lived, so the usual request timeout doesn't apply
+	cfg.Timeout = 0
+
+	watchClient, err := client.NewWithWatch(cfg, client.Options{})
+	if err != nil {
+		return nil, fmt.Errorf("failed creating watch client: %w", err)
+	}
+
+	return watchClient, nil
+}
@@ -0,0 +1,118 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/utilities"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// WatchObjectsPath is where the Server-Sent Events bridge for WatchObjects is served.
+const WatchObjectsPath = "/v1/watch/objects"
+
+// The in-process grpc-gateway transport doesn't support streaming calls, so
+// WatchObjects is bridged to HTTP by hand as a stream of Server-Sent Events.
+// Each event is named after the watch event type and carries the Object as
+// JSON, or the ListError for ERROR events.
+func watchObjectsHandler(mux *runtime.ServeMux, server pb.CoreServer) runtime.HandlerFunc {
+	return func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		msg := &pb.WatchObjectsRequest{}
+		if err := runtime.PopulateQueryParameters(msg, r.URL.Query(), utilities.NewDoubleArray(nil)); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			http.Error(w, "streaming unsupported", http.StatusInternalServerError)
+			return
+		}
+
+		stream := &sseWatchObjectsServer{
+			ctx:     r.Context(),
+			w:       w,
+			flusher: flusher,
+		}
+
+		if err := server.WatchObjects(msg, stream); err != nil {
+			if !stream.started {
+				_, outboundMarshaler := runtime.MarshalerForRequest(mux, r)
+				runtime.HTTPError(r.Context(), mux, outboundMarshaler, w, r, err)
+				return
+			}
+
+			_ = stream.writeEvent("error", []byte(fmt.Sprintf("%q", err.Error())))
+		}
+	}
+}
+
+type sseWatchObjectsServer struct {
+	grpc.ServerStream
+
+	ctx     context.Context
+	w       http.ResponseWriter
+	flusher http.Flusher
+	started bool
+}
+
+func (s *sseWatchObjectsServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *sseWatchObjectsServer) SetHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *sseWatchObjectsServer) SendHeader(metadata.MD) error {
+	return nil
+}
+
+func (s *sseWatchObjectsServer) SetTrailer(metadata.MD) {}
+
+func (s *sseWatchObjectsServer) SendMsg(m interface{}) error {
+	msg, ok := m.(*pb.WatchObjectsResponse)
+	if !ok {
+		return fmt.Errorf("unexpected message type %T", m)
+	}
+
+	return s.Send(msg)
+}
+
+func (s *sseWatchObjectsServer) Send(res *pb.WatchObjectsResponse) error {
+	var msg proto.Message = res.Object
+	if res.Error != nil {
+		msg = res.Error
+	}
+
+	data, err := protojson.Marshal(msg)
+	if err != nil {
+		return err
+	}
+
+	return s.writeEvent(res.Type, data)
+}
+
+func (s *sseWatchObjectsServer) writeEvent(event string, data []byte) error {
+	if !s.started {
+		s.w.Header().Set("Content-Type", "text/event-stream")
+		s.w.Header().Set("Cache-Control", "no-cache")
+		s.w.Header().Set("Connection", "keep-alive")
+		s.w.WriteHeader(http.StatusOK)
+		s.started = true
+	}
+
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, data); err != nil {
+		return err
+	}
+
+	s.flusher.Flush()
+
+	return nil
+}
@@ -0,0 +1,174 @@
+package server_test
+
+import (
+	"bufio"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/server"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"k8s.io/apimachinery/pkg/watch"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestWatchObjects(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, _ := makeGRPCServer(k8sEnv.Rest, t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).To(BeNil())
+
+	k, err := client.New(k8sEnv.Rest, client.Options{
+		Scheme: scheme,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ns := newNamespace(ctx, k, g)
+
+	gitRepo := makeGitRepo("watched", *ns)
+	kust := makeKustomization("watched", *ns, gitRepo)
+	g.Expect(k.Create(ctx, kust)).To(Succeed())
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	stream, err := c.WatchObjects(outgoingCtx, &pb.WatchObjectsRequest{
+		Kind:      kustomizev1.KustomizationKind,
+		Namespace: ns.Name,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	res, err := stream.Recv()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Type).To(Equal(string(watch.Added)))
+	g.Expect(res.Object.ClusterName).To(Equal("Default"))
+	g.Expect(res.Object.Payload).To(ContainSubstring(`"name":"watched"`))
+
+	g.Expect(k.Delete(ctx, kust)).To(Succeed())
+
+	res, err = stream.Recv()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Type).To(Equal(string(watch.Deleted)))
+}
+
+func TestWatchObjectsSendsErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, _ := makeGRPCServer(k8sEnv.Rest, t)
+
+	k, err := client.New(k8sEnv.Rest, client.Options{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ns := newNamespace(ctx, k, g)
+
+	// The user can see the namespace, but isn't allowed to watch anything in it
+	md := metadata.Pairs(MetadataUserKey, "bob", MetadataGroupsKey, "no-permissions")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	stream, err := c.WatchObjects(outgoingCtx, &pb.WatchObjectsRequest{
+		Kind:      kustomizev1.KustomizationKind,
+		Namespace: ns.Name,
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	res, err := stream.Recv()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Type).To(Equal(string(watch.Error)))
+	g.Expect(res.Error.ClusterName).To(Equal("Default"))
+	g.Expect(res.Error.Namespace).To(Equal(ns.Name))
+	g.Expect(res.Error.Message).To(ContainSubstring("forbidden"))
+}
+
+func TestWatchObjectsOverHTTPSendsErrors(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	_, coreCfg := makeGRPCServer(k8sEnv.Rest, t)
+
+	k, err := client.New(k8sEnv.Rest, client.Options{})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	ns := newNamespace(ctx, k, g)
+
+	// The user can see the namespace, but isn't allowed to watch anything in it
+	principal := &auth.UserPrincipal{ID: "bob", Groups: []string{"no-permissions"}}
+
+	g.Expect(coreCfg.ClustersManager.UpdateClusters(ctx)).To(Succeed())
+	g.Expect(coreCfg.ClustersManager.UpdateNamespaces(ctx)).To(Succeed())
+	coreCfg.ClustersManager.UpdateUserNamespaces(ctx, principal)
+
+	mux := runtime.NewServeMux()
+	g.Expect(server.Hydrate(ctx, mux, coreCfg)).To(Succeed())
+
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mux.ServeHTTP(w, r.WithContext(auth.WithPrincipal(r.Context(), principal)))
+	}))
+	defer ts.Close()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ts.URL+server.WatchObjectsPath+"?kind=Kustomization&namespace="+ns.Name, nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	res, err := http.DefaultClient.Do(req)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	defer res.Body.Close()
+
+	g.Expect(res.Header.Get("Content-Type")).To(Equal("text/event-stream"))
+
+	reader := bufio.NewReader(res.Body)
+
+	event, err := reader.ReadString('\n')
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(event).To(Equal("event: " + string(watch.Error) + "\n"))
+
+	data, err := reader.ReadString('\n')
+	g.Expect(err).NotTo(HaveOccurred())
+
+	listErr := &pb.ListError{}
+	g.Expect(protojson.Unmarshal([]byte(strings.TrimPrefix(strings.TrimSpace(data), "data: ")), listErr)).To(Succeed())
+	g.Expect(listErr.ClusterName).To(Equal("Default"))
+	g.Expect(listErr.Namespace).To(Equal(ns.Name))
+	g.Expect(listErr.Message).To(ContainSubstring("forbidden"))
+}
+
+func TestWatchObjectsWithoutNamespaces(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	c, _ := makeGRPCServer(k8sEnv.Rest, t)
+
+	md := metadata.Pairs(MetadataUserKey, "anne", MetadataGroupsKey, "system:masters")
+	outgoingCtx := metadata.NewOutgoingContext(ctx, md)
+
+	stream, err := c.WatchObjects(outgoingCtx, &pb.WatchObjectsRequest{
+		Kind:      kustomizev1.KustomizationKind,
+		Namespace: "does-not-exist",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = stream.Recv()
+	g.Expect(status.Code(err)).To(Equal(codes.NotFound))
+}
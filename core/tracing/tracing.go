@@ -0,0 +1,91 @@
+// Package tracing sets up OpenTelemetry tracing for the server, and has the
+// helpers the rest of the server uses to start spans.
+package tracing
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	// TracerName is the name of the tracer all the server's spans are started with.
+	TracerName = "github.com/weaveworks/weave-gitops"
+
+	serviceName = "weave-gitops"
+)
+
+// Options configures where traces are exported to.
+type Options struct {
+	// OTLPEndpoint is the host:port of an OTLP/HTTP collector, tracing is
+	// disabled when it's empty.
+	OTLPEndpoint string
+	// OTLPInsecure sends traces over plain HTTP.
+	OTLPInsecure bool
+	// SampleRatio is the fraction of traces started by the server that are
+	// sampled. Traces started by a caller follow the caller's decision.
+	SampleRatio float64
+	// ServiceVersion is reported as the version of the server.
+	ServiceVersion string
+}
+
+// Init exports traces to the OTLP endpoint in the options, and returns a
+// function that flushes the spans not yet exported. Nothing is traced when
+// no endpoint is set.
+func Init(ctx context.Context, opts Options) (func(context.Context) error, error) {
+	if opts.OTLPEndpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if opts.SampleRatio < 0 || opts.SampleRatio > 1 {
+		return nil, fmt.Errorf("trace sample ratio must be between 0 and 1, got %v", opts.SampleRatio)
+	}
+
+	exporterOpts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(opts.OTLPEndpoint)}
+	if opts.OTLPInsecure {
+		exporterOpts = append(exporterOpts, otlptracehttp.WithInsecure())
+	}
+
+	exporter, err := otlptracehttp.New(ctx, exporterOpts...)
+	if err != nil {
+		return nil, fmt.Errorf("could not create trace exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceNameKey.String(serviceName),
+			semconv.ServiceVersionKey.String(opts.ServiceVersion),
+		)),
+	)
+
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer for the server's spans. Until Init is called
+// with an endpoint it's a no-op.
+func Tracer() trace.Tracer {
+	return otel.Tracer(TracerName)
+}
+
+// EndSpan records the error on the span, if there is one, and ends it.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
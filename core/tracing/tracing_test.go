@@ -0,0 +1,30 @@
+package tracing
+
+import (
+	"context"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+func TestInit(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	previous := otel.GetTracerProvider()
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	shutdown, err := Init(context.Background(), Options{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(shutdown(context.Background())).To(Succeed())
+	g.Expect(otel.GetTracerProvider()).To(Equal(previous))
+
+	_, err = Init(context.Background(), Options{OTLPEndpoint: "localhost:4318", SampleRatio: 2})
+	g.Expect(err).To(MatchError(ContainSubstring("sample ratio")))
+
+	shutdown, err = Init(context.Background(), Options{OTLPEndpoint: "localhost:4318", OTLPInsecure: true, SampleRatio: 0.5})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(otel.GetTracerProvider()).To(BeAssignableToTypeOf(&sdktrace.TracerProvider{}))
+	g.Expect(shutdown(context.Background())).To(Succeed())
+}
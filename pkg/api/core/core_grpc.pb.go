@@ -22,6 +22,10 @@ type CoreClient interface {
 	GetObject(ctx context.Context, in *GetObjectRequest, opts ...grpc.CallOption) (*GetObjectResponse, error)
 	// ListObjects gets data about primary objects.
 	ListObjects(ctx context.Context, in *ListObjectsRequest, opts ...grpc.CallOption) (*ListObjectsResponse, error)
+	// WatchObjects streams changes to primary objects of a kind across all the
+	// clusters and namespaces the user can access. Over HTTP this is served as
+	// Server-Sent Events from GET /v1/watch/objects.
+	WatchObjects(ctx context.Context, in *WatchObjectsRequest, opts ...grpc.CallOption) (Core_WatchObjectsClient, error)
 	// ListFluxRuntimeObjects lists the flux runtime deployments from a cluster.
 	ListFluxRuntimeObjects(ctx context.Context, in *ListFluxRuntimeObjectsRequest, opts ...grpc.CallOption) (*ListFluxRuntimeObjectsResponse, error)
 	ListFluxCrds(ctx context.Context, in *ListFluxCrdsRequest, opts ...grpc.CallOption) (*ListFluxCrdsResponse, error)
@@ -31,6 +35,22 @@ type CoreClient interface {
 	// GetChildObjects returns the children of a given object, specified by a GroupVersionKind.
 	// Not all Kubernets objects have children. For example, a Deployment has a child ReplicaSet, but a Service has no child objects.
 	GetChildObjects(ctx context.Context, in *GetChildObjectsRequest, opts ...grpc.CallOption) (*GetChildObjectsResponse, error)
+	// GetDependencyGraph returns the graph of Kustomizations, HelmReleases and their sources in a cluster,
+	// linked by dependsOn, sourceRef and Kustomization inventory, to find what is blocking an automation.
+	GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*GetDependencyGraphResponse, error)
+	// GetHelmReleaseHistory returns the revisions of a HelmRelease kept in Helm's storage,
+	// newest first, optionally with a unified diff between two of them. Users who can get the
+	// HelmRelease can see its history, with the data of Secrets in the manifests and the values
+	// read from valuesFrom Secrets redacted.
+	GetHelmReleaseHistory(ctx context.Context, in *GetHelmReleaseHistoryRequest, opts ...grpc.CallOption) (*GetHelmReleaseHistoryResponse, error)
+	// RollbackHelmRelease pins a HelmRelease to the chart version and values of one of its
+	// previous revisions, and waits for it to be reconciled. The values Helm stored for a
+	// HelmRelease with valuesFrom include those read from secrets, so those are only rolled
+	// back if the values of the revision are the same as the current ones, otherwise the
+	// rollback is rejected. Only charts from HelmRepositories can be rolled back, other
+	// sources ignore the chart version. If the HelmRelease is managed by a Kustomization,
+	// the rollback lasts until that is next reconciled.
+	RollbackHelmRelease(ctx context.Context, in *RollbackHelmReleaseRequest, opts ...grpc.CallOption) (*RollbackHelmReleaseResponse, error)
 	// GetFluxNamespace returns with a namespace with a specific label.
 	GetFluxNamespace(ctx context.Context, in *GetFluxNamespaceRequest, opts ...grpc.CallOption) (*GetFluxNamespaceResponse, error)
 	// ListNamespaces returns with the list of available namespaces.
@@ -79,6 +99,38 @@ func (c *coreClient) ListObjects(ctx context.Context, in *ListObjectsRequest, op
 	return out, nil
 }
 
+func (c *coreClient) WatchObjects(ctx context.Context, in *WatchObjectsRequest, opts ...grpc.CallOption) (Core_WatchObjectsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Core_ServiceDesc.Streams[0], "/gitops_core.v1.Core/WatchObjects", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &coreWatchObjectsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Core_WatchObjectsClient interface {
+	Recv() (*WatchObjectsResponse, error)
+	grpc.ClientStream
+}
+
+type coreWatchObjectsClient struct {
+	grpc.ClientStream
+}
+
+func (x *coreWatchObjectsClient) Recv() (*WatchObjectsResponse, error) {
+	m := new(WatchObjectsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *coreClient) ListFluxRuntimeObjects(ctx context.Context, in *ListFluxRuntimeObjectsRequest, opts ...grpc.CallOption) (*ListFluxRuntimeObjectsResponse, error) {
 	out := new(ListFluxRuntimeObjectsResponse)
 	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/ListFluxRuntimeObjects", in, out, opts...)
@@ -115,6 +167,33 @@ func (c *coreClient) GetChildObjects(ctx context.Context, in *GetChildObjectsReq
 	return out, nil
 }
 
+func (c *coreClient) GetDependencyGraph(ctx context.Context, in *GetDependencyGraphRequest, opts ...grpc.CallOption) (*GetDependencyGraphResponse, error) {
+	out := new(GetDependencyGraphResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/GetDependencyGraph", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) GetHelmReleaseHistory(ctx context.Context, in *GetHelmReleaseHistoryRequest, opts ...grpc.CallOption) (*GetHelmReleaseHistoryResponse, error) {
+	out := new(GetHelmReleaseHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/GetHelmReleaseHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *coreClient) RollbackHelmRelease(ctx context.Context, in *RollbackHelmReleaseRequest, opts ...grpc.CallOption) (*RollbackHelmReleaseResponse, error) {
+	out := new(RollbackHelmReleaseResponse)
+	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/RollbackHelmRelease", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *coreClient) GetFluxNamespace(ctx context.Context, in *GetFluxNamespaceRequest, opts ...grpc.CallOption) (*GetFluxNamespaceResponse, error) {
 	out := new(GetFluxNamespaceResponse)
 	err := c.cc.Invoke(ctx, "/gitops_core.v1.Core/GetFluxNamespace", in, out, opts...)
@@ -204,6 +283,10 @@ type CoreServer interface {
 	GetObject(context.Context, *GetObjectRequest) (*GetObjectResponse, error)
 	// ListObjects gets data about primary objects.
 	ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error)
+	// WatchObjects streams changes to primary objects of a kind across all the
+	// clusters and namespaces the user can access. Over HTTP this is served as
+	// Server-Sent Events from GET /v1/watch/objects.
+	WatchObjects(*WatchObjectsRequest, Core_WatchObjectsServer) error
 	// ListFluxRuntimeObjects lists the flux runtime deployments from a cluster.
 	ListFluxRuntimeObjects(context.Context, *ListFluxRuntimeObjectsRequest) (*ListFluxRuntimeObjectsResponse, error)
 	ListFluxCrds(context.Context, *ListFluxCrdsRequest) (*ListFluxCrdsResponse, error)
@@ -213,6 +296,22 @@ type CoreServer interface {
 	// GetChildObjects returns the children of a given object, specified by a GroupVersionKind.
 	// Not all Kubernets objects have children. For example, a Deployment has a child ReplicaSet, but a Service has no child objects.
 	GetChildObjects(context.Context, *GetChildObjectsRequest) (*GetChildObjectsResponse, error)
+	// GetDependencyGraph returns the graph of Kustomizations, HelmReleases and their sources in a cluster,
+	// linked by dependsOn, sourceRef and Kustomization inventory, to find what is blocking an automation.
+	GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*GetDependencyGraphResponse, error)
+	// GetHelmReleaseHistory returns the revisions of a HelmRelease kept in Helm's storage,
+	// newest first, optionally with a unified diff between two of them. Users who can get the
+	// HelmRelease can see its history, with the data of Secrets in the manifests and the values
+	// read from valuesFrom Secrets redacted.
+	GetHelmReleaseHistory(context.Context, *GetHelmReleaseHistoryRequest) (*GetHelmReleaseHistoryResponse, error)
+	// RollbackHelmRelease pins a HelmRelease to the chart version and values of one of its
+	// previous revisions, and waits for it to be reconciled. The values Helm stored for a
+	// HelmRelease with valuesFrom include those read from secrets, so those are only rolled
+	// back if the values of the revision are the same as the current ones, otherwise the
+	// rollback is rejected. Only charts from HelmRepositories can be rolled back, other
+	// sources ignore the chart version. If the HelmRelease is managed by a Kustomization,
+	// the rollback lasts until that is next reconciled.
+	RollbackHelmRelease(context.Context, *RollbackHelmReleaseRequest) (*RollbackHelmReleaseResponse, error)
 	// GetFluxNamespace returns with a namespace with a specific label.
 	GetFluxNamespace(context.Context, *GetFluxNamespaceRequest) (*GetFluxNamespaceResponse, error)
 	// ListNamespaces returns with the list of available namespaces.
@@ -246,6 +345,9 @@ func (UnimplementedCoreServer) GetObject(context.Context, *GetObjectRequest) (*G
 func (UnimplementedCoreServer) ListObjects(context.Context, *ListObjectsRequest) (*ListObjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListObjects not implemented")
 }
+func (UnimplementedCoreServer) WatchObjects(*WatchObjectsRequest, Core_WatchObjectsServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchObjects not implemented")
+}
 func (UnimplementedCoreServer) ListFluxRuntimeObjects(context.Context, *ListFluxRuntimeObjectsRequest) (*ListFluxRuntimeObjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListFluxRuntimeObjects not implemented")
 }
@@ -258,6 +360,15 @@ func (UnimplementedCoreServer) GetReconciledObjects(context.Context, *GetReconci
 func (UnimplementedCoreServer) GetChildObjects(context.Context, *GetChildObjectsRequest) (*GetChildObjectsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetChildObjects not implemented")
 }
+func (UnimplementedCoreServer) GetDependencyGraph(context.Context, *GetDependencyGraphRequest) (*GetDependencyGraphResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDependencyGraph not implemented")
+}
+func (UnimplementedCoreServer) GetHelmReleaseHistory(context.Context, *GetHelmReleaseHistoryRequest) (*GetHelmReleaseHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHelmReleaseHistory not implemented")
+}
+func (UnimplementedCoreServer) RollbackHelmRelease(context.Context, *RollbackHelmReleaseRequest) (*RollbackHelmReleaseResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RollbackHelmRelease not implemented")
+}
 func (UnimplementedCoreServer) GetFluxNamespace(context.Context, *GetFluxNamespaceRequest) (*GetFluxNamespaceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetFluxNamespace not implemented")
 }
@@ -334,6 +445,27 @@ func _Core_ListObjects_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_WatchObjects_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchObjectsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(CoreServer).WatchObjects(m, &coreWatchObjectsServer{stream})
+}
+
+type Core_WatchObjectsServer interface {
+	Send(*WatchObjectsResponse) error
+	grpc.ServerStream
+}
+
+type coreWatchObjectsServer struct {
+	grpc.ServerStream
+}
+
+func (x *coreWatchObjectsServer) Send(m *WatchObjectsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Core_ListFluxRuntimeObjects_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListFluxRuntimeObjectsRequest)
 	if err := dec(in); err != nil {
@@ -406,6 +538,60 @@ func _Core_GetChildObjects_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Core_GetDependencyGraph_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDependencyGraphRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetDependencyGraph(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/GetDependencyGraph",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetDependencyGraph(ctx, req.(*GetDependencyGraphRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_GetHelmReleaseHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHelmReleaseHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).GetHelmReleaseHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/GetHelmReleaseHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).GetHelmReleaseHistory(ctx, req.(*GetHelmReleaseHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Core_RollbackHelmRelease_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RollbackHelmReleaseRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CoreServer).RollbackHelmRelease(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gitops_core.v1.Core/RollbackHelmRelease",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CoreServer).RollbackHelmRelease(ctx, req.(*RollbackHelmReleaseRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Core_GetFluxNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetFluxNamespaceRequest)
 	if err := dec(in); err != nil {
@@ -599,6 +785,18 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetChildObjects",
 			Handler:    _Core_GetChildObjects_Handler,
 		},
+		{
+			MethodName: "GetDependencyGraph",
+			Handler:    _Core_GetDependencyGraph_Handler,
+		},
+		{
+			MethodName: "GetHelmReleaseHistory",
+			Handler:    _Core_GetHelmReleaseHistory_Handler,
+		},
+		{
+			MethodName: "RollbackHelmRelease",
+			Handler:    _Core_RollbackHelmRelease_Handler,
+		},
 		{
 			MethodName: "GetFluxNamespace",
 			Handler:    _Core_GetFluxNamespace_Handler,
@@ -636,6 +834,12 @@ var Core_ServiceDesc = grpc.ServiceDesc{
 			Handler:    _Core_IsCRDAvailable_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchObjects",
+			Handler:       _Core_WatchObjects_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "api/core/core.proto",
 }
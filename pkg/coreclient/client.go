@@ -0,0 +1,247 @@
+// Package coreclient talks to the core API of a running gitops-server through
+// its grpc-gateway HTTP endpoints, the same ones the dashboard uses.
+package coreclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/cookiejar"
+	"net/url"
+	"strings"
+	"sync"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrUnauthorized is returned when the server rejects the credentials.
+var ErrUnauthorized = errors.New("the server rejected the credentials, check --username and --password")
+
+// Options configure how the client reaches and authenticates with the server.
+type Options struct {
+	Endpoint              string
+	Username              string
+	Password              string
+	InsecureSkipTLSVerify bool
+}
+
+// Client calls the core API over HTTP. When a username is set, the client
+// signs in once and reuses the session cookie for every following call. It's
+// safe for concurrent use, concurrent calls wait for the one sign in, and
+// rejected credentials aren't tried again so they don't lock the account.
+type Client struct {
+	opts    Options
+	baseURL *url.URL
+	http    *http.Client
+
+	signInMu  sync.Mutex
+	signedIn  bool
+	signInErr error
+}
+
+// New returns a client for the server at opts.Endpoint.
+func New(opts Options) (*Client, error) {
+	if opts.Endpoint == "" {
+		return nil, errors.New("endpoint is required")
+	}
+
+	baseURL, err := url.Parse(strings.TrimSuffix(opts.Endpoint, "/"))
+	if err != nil {
+		return nil, fmt.Errorf("parsing endpoint: %w", err)
+	}
+
+	jar, err := cookiejar.New(nil)
+	if err != nil {
+		return nil, fmt.Errorf("creating cookie jar: %w", err)
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = &tls.Config{
+		InsecureSkipVerify: opts.InsecureSkipTLSVerify, //nolint:gosec // only when asked for with --insecure-skip-tls-verify
+	}
+
+	return &Client{
+		opts:    opts,
+		baseURL: baseURL,
+		http:    &http.Client{Jar: jar, Transport: transport},
+	}, nil
+}
+
+// ListObjects lists the objects of msg.Kind across the clusters the user can see.
+func (c *Client) ListObjects(ctx context.Context, msg *pb.ListObjectsRequest) (*pb.ListObjectsResponse, error) {
+	res := &pb.ListObjectsResponse{}
+
+	return res, c.do(ctx, http.MethodPost, "/v1/objects", nil, msg, res)
+}
+
+// ListEvents lists the events recorded for msg.InvolvedObject.
+func (c *Client) ListEvents(ctx context.Context, msg *pb.ListEventsRequest) (*pb.ListEventsResponse, error) {
+	query := url.Values{}
+
+	if ref := msg.InvolvedObject; ref != nil {
+		query.Set("involvedObject.kind", ref.Kind)
+		query.Set("involvedObject.name", ref.Name)
+		query.Set("involvedObject.namespace", ref.Namespace)
+		query.Set("involvedObject.clusterName", ref.ClusterName)
+	}
+
+	res := &pb.ListEventsResponse{}
+
+	return res, c.do(ctx, http.MethodGet, "/v1/events", query, nil, res)
+}
+
+// SyncFluxObject requests a reconciliation of the objects and waits for it to complete.
+func (c *Client) SyncFluxObject(ctx context.Context, msg *pb.SyncFluxObjectRequest) (*pb.SyncFluxObjectResponse, error) {
+	res := &pb.SyncFluxObjectResponse{}
+
+	return res, c.do(ctx, http.MethodPost, "/v1/sync", nil, msg, res)
+}
+
+// ToggleSuspendResource suspends or resumes the objects.
+func (c *Client) ToggleSuspendResource(ctx context.Context, msg *pb.ToggleSuspendResourceRequest) (*pb.ToggleSuspendResourceResponse, error) {
+	res := &pb.ToggleSuspendResourceResponse{}
+
+	return res, c.do(ctx, http.MethodPost, "/v1/suspend", nil, msg, res)
+}
+
+// GetSessionLogs returns the logs of a GitOps Run session written after msg.Token.
+func (c *Client) GetSessionLogs(ctx context.Context, msg *pb.GetSessionLogsRequest) (*pb.GetSessionLogsResponse, error) {
+	res := &pb.GetSessionLogsResponse{}
+
+	return res, c.do(ctx, http.MethodPost, "/v1/session_logs", nil, msg, res)
+}
+
+func (c *Client) signIn(ctx context.Context) error {
+	if c.opts.Username == "" {
+		return nil
+	}
+
+	c.signInMu.Lock()
+	defer c.signInMu.Unlock()
+
+	if c.signedIn {
+		return nil
+	}
+
+	if c.signInErr != nil {
+		return c.signInErr
+	}
+
+	body, err := json.Marshal(auth.LoginRequest{Username: c.opts.Username, Password: c.opts.Password})
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url("/oauth2/sign_in", nil), bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("signing in: %w", err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized {
+		c.signInErr = ErrUnauthorized
+		return c.signInErr
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("signing in: %w", responseError(res))
+	}
+
+	c.signedIn = true
+
+	return nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, query url.Values, in, out proto.Message) error {
+	if err := c.signIn(ctx); err != nil {
+		return err
+	}
+
+	var body io.Reader
+
+	if in != nil {
+		data, err := protojson.Marshal(in)
+		if err != nil {
+			return fmt.Errorf("encoding request: %w", err)
+		}
+
+		body = bytes.NewReader(data)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.url(path, query), body)
+	if err != nil {
+		return err
+	}
+
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	res, err := c.http.Do(req)
+	if err != nil {
+		return fmt.Errorf("calling %s: %w", path, err)
+	}
+	defer res.Body.Close()
+
+	if res.StatusCode == http.StatusUnauthorized {
+		return ErrUnauthorized
+	}
+
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("calling %s: %w", path, responseError(res))
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(data, out); err != nil {
+		return fmt.Errorf("decoding response: %w", err)
+	}
+
+	return nil
+}
+
+func (c *Client) url(path string, query url.Values) string {
+	u := *c.baseURL
+	u.Path = strings.TrimSuffix(u.Path, "/") + path
+	u.RawQuery = query.Encode()
+
+	return u.String()
+}
+
+// responseError turns a failed response into an error, using the message of
+// the gateway's error body when there is one.
+func responseError(res *http.Response) error {
+	var gatewayErr struct {
+		Message string `json:"message"`
+	}
+
+	data, _ := io.ReadAll(res.Body)
+
+	if err := json.Unmarshal(data, &gatewayErr); err == nil && gatewayErr.Message != "" {
+		return fmt.Errorf("%s: %s", res.Status, gatewayErr.Message)
+	}
+
+	if msg := strings.TrimSpace(string(data)); msg != "" {
+		return fmt.Errorf("%s: %s", res.Status, msg)
+	}
+
+	return errors.New(res.Status)
+}
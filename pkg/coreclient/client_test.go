@@ -0,0 +1,192 @@
+package coreclient_test
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/coreclient"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+)
+
+func TestListObjectsSignsInOnce(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	signIns := 0
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/sign_in", func(w http.ResponseWriter, r *http.Request) {
+		signIns++
+
+		var login auth.LoginRequest
+		g.Expect(json.NewDecoder(r.Body).Decode(&login)).To(Succeed())
+
+		if login.Username != "admin" || login.Password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{Name: auth.IDTokenCookieName, Value: "token", Path: "/"})
+	})
+	mux.HandleFunc("/v1/objects", func(w http.ResponseWriter, r *http.Request) {
+		if cookie, err := r.Cookie(auth.IDTokenCookieName); err != nil || cookie.Value != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		data, err := io.ReadAll(r.Body)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		req := &pb.ListObjectsRequest{}
+		g.Expect(protojson.Unmarshal(data, req)).To(Succeed())
+
+		if req.Kind != "Kustomization" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"code":3,"message":"not a recognized object kind"}`))
+
+			return
+		}
+
+		res, err := protojson.Marshal(&pb.ListObjectsResponse{
+			Objects: []*pb.Object{{ClusterName: "Default", Tenant: "team-a", Payload: `{"metadata":{"name":"apps"}}`}},
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		_, _ = w.Write(res)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := coreclient.New(coreclient.Options{Endpoint: srv.URL, Username: "admin", Password: "secret"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	res, err := client.ListObjects(context.Background(), &pb.ListObjectsRequest{Kind: "Kustomization"})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(res.Objects).To(HaveLen(1))
+	g.Expect(res.Objects[0].Tenant).To(Equal("team-a"))
+
+	_, err = client.ListObjects(context.Background(), &pb.ListObjectsRequest{Kind: "Unknown"})
+	g.Expect(err).To(MatchError(ContainSubstring("not a recognized object kind")))
+	g.Expect(signIns).To(Equal(1))
+
+	client, err = coreclient.New(coreclient.Options{Endpoint: srv.URL, Username: "admin", Password: "wrong"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = client.ListObjects(context.Background(), &pb.ListObjectsRequest{Kind: "Kustomization"})
+	g.Expect(err).To(MatchError(coreclient.ErrUnauthorized))
+}
+
+func TestConcurrentCallsSignInOnce(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	var signIns int32
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/oauth2/sign_in", func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&signIns, 1)
+		w.WriteHeader(http.StatusUnauthorized)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := coreclient.New(coreclient.Options{Endpoint: srv.URL, Username: "admin", Password: "mistyped"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	wg := sync.WaitGroup{}
+
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := client.SyncFluxObject(context.Background(), &pb.SyncFluxObjectRequest{})
+			g.Expect(err).To(MatchError(coreclient.ErrUnauthorized))
+		}()
+	}
+
+	wg.Wait()
+
+	g.Expect(atomic.LoadInt32(&signIns)).To(Equal(int32(1)), "a mistyped password must only be tried once")
+}
+
+func TestParseKindName(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	for arg, want := range map[string]string{
+		"kustomization/apps":    "Kustomization",
+		"ks/apps":               "Kustomization",
+		"HelmReleases/apps":     "HelmRelease",
+		"helmrepositories/apps": "HelmRepository",
+		"imagepolicy/apps":      "ImagePolicy",
+	} {
+		kind, name, err := coreclient.ParseKindName(arg)
+		g.Expect(err).NotTo(HaveOccurred(), arg)
+		g.Expect(kind).To(Equal(want), arg)
+		g.Expect(name).To(Equal("apps"), arg)
+	}
+
+	_, _, err := coreclient.ParseKindName("apps")
+	g.Expect(err).To(MatchError(ContainSubstring("<kind>/<name>")))
+
+	_, _, err = coreclient.ParseKindName("deployment/apps")
+	g.Expect(err).To(MatchError(ContainSubstring("unknown kind")))
+}
+
+func TestObjectRefsWithSelector(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/v1/objects", func(w http.ResponseWriter, r *http.Request) {
+		data, err := io.ReadAll(r.Body)
+		g.Expect(err).NotTo(HaveOccurred())
+
+		req := &pb.ListObjectsRequest{}
+		g.Expect(protojson.Unmarshal(data, req)).To(Succeed())
+		g.Expect(req.Kind).To(Equal("HelmRelease"))
+		g.Expect(req.Labels).To(Equal(map[string]string{"team": "a", "env": "dev"}))
+
+		res, err := protojson.Marshal(&pb.ListObjectsResponse{
+			Objects: []*pb.Object{
+				{ClusterName: "Default", Payload: `{"metadata":{"name":"podinfo","namespace":"apps"}}`},
+				{ClusterName: "dev/leaf", Payload: `{"metadata":{"name":"redis","namespace":"apps"}}`},
+			},
+		})
+		g.Expect(err).NotTo(HaveOccurred())
+
+		_, _ = w.Write(res)
+	})
+
+	srv := httptest.NewServer(mux)
+	defer srv.Close()
+
+	client, err := coreclient.New(coreclient.Options{Endpoint: srv.URL})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	refs, err := client.ObjectRefs(context.Background(), []string{"hr"}, "team=a,env=dev", "apps", "")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(refs).To(HaveLen(2))
+	g.Expect(refs[1].Name).To(Equal("redis"))
+	g.Expect(refs[1].ClusterName).To(Equal("dev/leaf"))
+
+	refs, err = client.ObjectRefs(context.Background(), []string{"ks/apps", "hr/podinfo"}, "", "flux-system", "Default")
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(refs).To(ConsistOf(
+		&pb.ObjectRef{Kind: "Kustomization", Name: "apps", Namespace: "flux-system", ClusterName: "Default"},
+		&pb.ObjectRef{Kind: "HelmRelease", Name: "podinfo", Namespace: "flux-system", ClusterName: "Default"},
+	))
+
+	_, err = client.ObjectRefs(context.Background(), []string{"hr", "ks"}, "team=a", "apps", "")
+	g.Expect(err).To(MatchError(ContainSubstring("exactly one <kind>")))
+}
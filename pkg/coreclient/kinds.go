@@ -0,0 +1,158 @@
+package coreclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	imgautomationv1 "github.com/fluxcd/image-automation-controller/api/v1beta1"
+	reflectorv1 "github.com/fluxcd/image-reflector-controller/api/v1beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	notificationv1 "github.com/fluxcd/notification-controller/api/v1beta1"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// SourceKinds are the Flux source kinds, in the order they're listed.
+var SourceKinds = []string{
+	sourcev1.GitRepositoryKind,
+	sourcev1.OCIRepositoryKind,
+	sourcev1.HelmRepositoryKind,
+	sourcev1.HelmChartKind,
+	sourcev1.BucketKind,
+}
+
+// kindAliases maps the lower case names users type on the command line,
+// including the short names kubectl knows them by, to Flux kinds.
+var kindAliases = map[string]string{
+	"kustomization":         kustomizev1.KustomizationKind,
+	"ks":                    kustomizev1.KustomizationKind,
+	"helmrelease":           helmv2.HelmReleaseKind,
+	"hr":                    helmv2.HelmReleaseKind,
+	"gitrepository":         sourcev1.GitRepositoryKind,
+	"gitrepo":               sourcev1.GitRepositoryKind,
+	"ocirepository":         sourcev1.OCIRepositoryKind,
+	"ocirepo":               sourcev1.OCIRepositoryKind,
+	"helmrepository":        sourcev1.HelmRepositoryKind,
+	"helmrepo":              sourcev1.HelmRepositoryKind,
+	"helmchart":             sourcev1.HelmChartKind,
+	"bucket":                sourcev1.BucketKind,
+	"imagerepository":       reflectorv1.ImageRepositoryKind,
+	"imagepolicy":           reflectorv1.ImagePolicyKind,
+	"imageupdateautomation": imgautomationv1.ImageUpdateAutomationKind,
+	"alert":                 notificationv1.AlertKind,
+	"provider":              notificationv1.ProviderKind,
+	"receiver":              notificationv1.ReceiverKind,
+}
+
+// ParseKind returns the Flux kind for a name typed by the user, accepting any
+// case, plurals and kubectl's short names.
+func ParseKind(s string) (string, error) {
+	name := strings.ToLower(s)
+
+	if kind, ok := kindAliases[name]; ok {
+		return kind, nil
+	}
+
+	if kind, ok := kindAliases[strings.TrimSuffix(name, "s")]; ok {
+		return kind, nil
+	}
+
+	if kind, ok := kindAliases[strings.TrimSuffix(name, "ies")+"y"]; ok {
+		return kind, nil
+	}
+
+	return "", fmt.Errorf("unknown kind %q", s)
+}
+
+// ParseKindName splits a "<kind>/<name>" argument.
+func ParseKindName(s string) (kind, name string, err error) {
+	parts := strings.Split(s, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("%q is not in the form <kind>/<name>", s)
+	}
+
+	kind, err = ParseKind(parts[0])
+	if err != nil {
+		return "", "", err
+	}
+
+	return kind, parts[1], nil
+}
+
+// ObjectRefs resolves command line arguments to the objects they refer to.
+// The arguments are either one or more "<kind>/<name>", or a single "<kind>"
+// together with a label selector, which is resolved by listing the objects
+// of that kind that have all the labels.
+func (c *Client) ObjectRefs(ctx context.Context, args []string, selector, namespace, cluster string) ([]*pb.ObjectRef, error) {
+	if selector == "" {
+		refs := []*pb.ObjectRef{}
+
+		for _, arg := range args {
+			kind, name, err := ParseKindName(arg)
+			if err != nil {
+				return nil, err
+			}
+
+			refs = append(refs, &pb.ObjectRef{Kind: kind, Name: name, Namespace: namespace, ClusterName: cluster})
+		}
+
+		return refs, nil
+	}
+
+	if len(args) != 1 {
+		return nil, errors.New("exactly one <kind> argument is required with a label selector")
+	}
+
+	kind, err := ParseKind(args[0])
+	if err != nil {
+		return nil, err
+	}
+
+	matchLabels, err := labels.ConvertSelectorToLabelsMap(selector)
+	if err != nil {
+		return nil, fmt.Errorf("parsing label selector: %w", err)
+	}
+
+	res, err := c.ListObjects(ctx, &pb.ListObjectsRequest{
+		Kind:        kind,
+		Namespace:   namespace,
+		ClusterName: cluster,
+		Labels:      matchLabels,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("listing %s objects: %w", kind, err)
+	}
+
+	if len(res.Errors) > 0 {
+		return nil, fmt.Errorf("listing %s objects in cluster %s: %s", kind, res.Errors[0].ClusterName, res.Errors[0].Message)
+	}
+
+	refs := []*pb.ObjectRef{}
+
+	for _, obj := range res.Objects {
+		var meta struct {
+			Metadata struct {
+				Name      string `json:"name"`
+				Namespace string `json:"namespace"`
+			} `json:"metadata"`
+		}
+
+		if err := json.Unmarshal([]byte(obj.Payload), &meta); err != nil {
+			return nil, fmt.Errorf("decoding %s from cluster %s: %w", kind, obj.ClusterName, err)
+		}
+
+		refs = append(refs, &pb.ObjectRef{
+			Kind:        kind,
+			Name:        meta.Metadata.Name,
+			Namespace:   meta.Metadata.Namespace,
+			ClusterName: obj.ClusterName,
+		})
+	}
+
+	return refs, nil
+}
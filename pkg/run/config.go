@@ -0,0 +1,96 @@
+package run
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+// ConfigFileName is the name of the file in the target directory, or at the
+// root of the git repository, that configures GitOps Run for the project.
+const ConfigFileName = ".gitops-run.yaml"
+
+// Config is the GitOps Run configuration of a project. Each setting has the
+// same meaning as the flag of the same name, and flags that are set on the
+// command line override it.
+type Config struct {
+	Components        []string         `json:"components,omitempty"`
+	ComponentsExtra   []string         `json:"componentsExtra,omitempty"`
+	Timeout           *metav1.Duration `json:"timeout,omitempty"`
+	DecryptionKeyFile string           `json:"decryptionKeyFile,omitempty"`
+	SessionNamespace  string           `json:"sessionNamespace,omitempty"`
+	Dashboard         DashboardConfig  `json:"dashboard,omitempty"`
+	// PortForwards are forwarded in addition to the ones in --port-forward,
+	// apart from those using the host port of one of them.
+	PortForwards []PortForwardConfig `json:"portForwards,omitempty"`
+	// Ignore has more gitignore patterns, relative to the root directory, of
+	// files that aren't synced.
+	Ignore []string `json:"ignore,omitempty"`
+}
+
+// DashboardConfig configures the GitOps Dashboard GitOps Run installs.
+type DashboardConfig struct {
+	Port           string `json:"port,omitempty"`
+	HashedPassword string `json:"hashedPassword,omitempty"`
+	SkipInstall    bool   `json:"skipInstall,omitempty"`
+}
+
+// PortForwardConfig is a port forward in the configuration file, with the
+// same fields as a --port-forward spec.
+type PortForwardConfig struct {
+	// Name is what the forward is shown as, it defaults to the name of the resource.
+	Name      string `json:"name,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	// Resource is the kind and name of the resource, e.g. svc/app.
+	Resource string `json:"resource"`
+	// Port is the host port and the container port, e.g. 8080:80.
+	Port string `json:"port"`
+}
+
+// Spec returns the --port-forward spec of the forward.
+func (c PortForwardConfig) Spec() string {
+	spec := fmt.Sprintf("port=%s,resource=%s", c.Port, c.Resource)
+
+	if c.Namespace != "" {
+		spec += ",namespace=" + c.Namespace
+	}
+
+	if c.Name != "" {
+		spec += ",name=" + c.Name
+	}
+
+	return spec
+}
+
+// LoadConfig reads the configuration file in the first of the directories
+// that has one, usually the target directory and then the root directory,
+// and returns the directory it was read from, which relative paths in it are
+// relative to. It's not an error for there not to be one, the configuration
+// is empty and the directory is "" then.
+func LoadConfig(dirs ...string) (*Config, string, error) {
+	for _, dir := range dirs {
+		path := filepath.Join(dir, ConfigFileName)
+
+		data, err := os.ReadFile(path)
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+
+			return nil, "", err
+		}
+
+		config := &Config{}
+		if err := yaml.UnmarshalStrict(data, config); err != nil {
+			return nil, "", fmt.Errorf("invalid %s: %w", path, err)
+		}
+
+		return config, dir, nil
+	}
+
+	return &Config{}, "", nil
+}
@@ -0,0 +1,101 @@
+package run
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("LoadConfig", func() {
+	var dir string
+
+	BeforeEach(func() {
+		dir = GinkgoT().TempDir()
+	})
+
+	It("reads every setting", func() {
+		Expect(os.WriteFile(filepath.Join(dir, ConfigFileName), []byte(`
+components: [source-controller, kustomize-controller]
+componentsExtra: [image-reflector-controller]
+timeout: 10m
+decryptionKeyFile: ./age.key
+sessionNamespace: dev
+dashboard:
+  port: "9002"
+  hashedPassword: hash
+  skipInstall: true
+ignore: ["*.md"]
+`), 0644)).To(Succeed())
+
+		config, configDir, err := LoadConfig(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(configDir).To(Equal(dir))
+		Expect(config).To(Equal(&Config{
+			Components:        []string{"source-controller", "kustomize-controller"},
+			ComponentsExtra:   []string{"image-reflector-controller"},
+			Timeout:           &metav1.Duration{Duration: 10 * time.Minute},
+			DecryptionKeyFile: "./age.key",
+			SessionNamespace:  "dev",
+			Dashboard:         DashboardConfig{Port: "9002", HashedPassword: "hash", SkipInstall: true},
+			Ignore:            []string{"*.md"},
+		}))
+	})
+
+	It("reads the config of the first directory that has one", func() {
+		targetDir := filepath.Join(dir, "apps", "dev")
+		Expect(os.MkdirAll(targetDir, 0755)).To(Succeed())
+		Expect(os.WriteFile(filepath.Join(dir, ConfigFileName), []byte("sessionNamespace: root\n"), 0644)).To(Succeed())
+
+		config, configDir, err := LoadConfig(targetDir, dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.SessionNamespace).To(Equal("root"))
+		Expect(configDir).To(Equal(dir))
+
+		Expect(os.WriteFile(filepath.Join(targetDir, ConfigFileName), []byte("sessionNamespace: target\n"), 0644)).To(Succeed())
+
+		config, configDir, err = LoadConfig(targetDir, dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.SessionNamespace).To(Equal("target"))
+		Expect(configDir).To(Equal(targetDir))
+	})
+
+	It("returns an empty config when there's no config file", func() {
+		config, configDir, err := LoadConfig(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config).To(Equal(&Config{}))
+		Expect(configDir).To(BeEmpty())
+	})
+
+	It("reads the port forwards", func() {
+		Expect(os.WriteFile(filepath.Join(dir, ConfigFileName), []byte(`
+portForwards:
+  - name: api
+    namespace: dev
+    resource: svc/backend
+    port: "9898:9898"
+  - resource: deployment/frontend
+    port: "8080:80"
+`), 0644)).To(Succeed())
+
+		config, _, err := LoadConfig(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(config.PortForwards).To(HaveLen(2))
+		Expect(config.PortForwards[0].Spec()).To(Equal("port=9898:9898,resource=svc/backend,namespace=dev,name=api"))
+		Expect(config.PortForwards[1].Spec()).To(Equal("port=8080:80,resource=deployment/frontend"))
+	})
+
+	It("rejects unknown fields", func() {
+		Expect(os.WriteFile(filepath.Join(dir, ConfigFileName), []byte(`
+portForward:
+  - resource: svc/backend
+    port: "9898:9898"
+`), 0644)).To(Succeed())
+
+		_, _, err := LoadConfig(dir)
+		Expect(err).To(MatchError(ContainSubstring("invalid " + filepath.Join(dir, ConfigFileName))))
+	})
+})
@@ -2,7 +2,7 @@ package install
 
 import (
 	"context"
-	"fmt"
+	"encoding/json"
 	"github.com/weaveworks/weave-gitops/pkg/run/watch"
 	"os"
 	"path/filepath"
@@ -36,7 +36,46 @@ func makeVClusterHelmRepository(namespace string) (*sourcev1.HelmRepository, err
 	return helmRepository, nil
 }
 
-func makeVClusterHelmRelease(name string, namespace string, fluxNamespace string, command string, portForwards []string, automationKind string) (*helmv2.HelmRelease, error) {
+func makeVClusterHelmRelease(name string, namespace string, fluxNamespace string, args []string, portForwards []string, dashboardHashedPassword string, automationKind string) (*helmv2.HelmRelease, error) {
+	// the args are kept as JSON so that they can be split up again exactly.
+	command, err := json.Marshal(args)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations := map[string]string{
+		"run.weave.works/cli-version":     version.Version,
+		"run.weave.works/port-forward":    strings.Join(portForwards, ","),
+		session.CommandAnnotation:         string(command),
+		"run.weave.works/automation-kind": automationKind,
+		"run.weave.works/namespace":       namespace,
+		"run.weave.works/flux-namespace":  fluxNamespace,
+	}
+
+	// the dashboard password isn't in the args when it's generated or read
+	// from the config file, so it's recorded for attaching to the session.
+	if dashboardHashedPassword != "" {
+		annotations[session.DashboardHashedPasswordAnnotation] = dashboardHashedPassword
+	}
+
+	values, err := json.Marshal(map[string]interface{}{
+		"labels": map[string]string{
+			"app.kubernetes.io/part-of": "gitops-run",
+		},
+		"annotations": annotations,
+		"mapServices": map[string]interface{}{
+			"fromVirtual": []map[string]string{
+				{
+					"from": watch.GitOpsRunNamespace + "/" + watch.RunDevBucketName,
+					"to":   name + "-bucket",
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
 	helmRelease := &helmv2.HelmRelease{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      name,
@@ -45,6 +84,9 @@ func makeVClusterHelmRelease(name string, namespace string, fluxNamespace string
 				"app":                       "vcluster",
 				"app.kubernetes.io/part-of": "gitops-run",
 			},
+			Annotations: map[string]string{
+				session.HeartbeatAnnotation: time.Now().UTC().Format(time.RFC3339),
+			},
 		},
 		Spec: helmv2.HelmReleaseSpec{
 			Chart: helmv2.HelmChartTemplate{
@@ -65,45 +107,14 @@ func makeVClusterHelmRelease(name string, namespace string, fluxNamespace string
 			Upgrade: &helmv2.Upgrade{
 				CRDs: helmv2.CreateReplace,
 			},
-			Values: &apiextensions.JSON{Raw: []byte(fmt.Sprintf(`
-{
-  "labels": {
-    "app.kubernetes.io/part-of": "gitops-run"
-  },
-  "annotations": {
-    "run.weave.works/cli-version": "%s",
-    "run.weave.works/port-forward": "%s",
-    "run.weave.works/command": "%s",
-    "run.weave.works/automation-kind": "%s",
-    "run.weave.works/namespace": "%s",
-    "run.weave.works/flux-namespace": "%s"
-  },
-  "mapServices": {
-    "fromVirtual": [
-      {
-        "from": "%s/%s",
-        "to": "%s-bucket"
-      }
-    ]
-  }
-}`,
-				version.Version,
-				strings.Join(portForwards, ","),
-				command,
-				automationKind,
-				namespace,
-				fluxNamespace,
-				watch.GitOpsRunNamespace,
-				watch.RunDevBucketName,
-				name,
-			))},
+			Values: &apiextensions.JSON{Raw: values},
 		},
 	}
 
 	return helmRelease, nil
 }
 
-func installVCluster(kubeClient client.Client, name string, namespace string, fluxNamespace string, portForwards []string, automationKind string) error {
+func installVCluster(kubeClient client.Client, name string, namespace string, fluxNamespace string, portForwards []string, dashboardHashedPassword string, automationKind string) error {
 	helmRepo, err := makeVClusterHelmRepository(namespace)
 	if err != nil {
 		return err
@@ -118,9 +129,8 @@ func installVCluster(kubeClient client.Client, name string, namespace string, fl
 	}
 
 	args := append([]string{filepath.Base(os.Args[0])}, os.Args[1:]...)
-	command := strings.Join(args, " ")
 
-	helmRelease, err := makeVClusterHelmRelease(name, namespace, fluxNamespace, command, portForwards, automationKind)
+	helmRelease, err := makeVClusterHelmRelease(name, namespace, fluxNamespace, args, portForwards, dashboardHashedPassword, automationKind)
 	if err != nil {
 		return err
 	}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/run/session"
 )
 
 func TestMakeVClusterHelmReleaseAnnotations(t *testing.T) {
@@ -14,12 +15,14 @@ func TestMakeVClusterHelmReleaseAnnotations(t *testing.T) {
 		"name",
 		"namespace",
 		"flux-system",
-		"command", []string{"9999", "1111"},
+		[]string{"gitops", "beta", "run", "./my app", "--timeout", "3m"}, []string{"9999", "1111"},
+		"$2a$10$hash",
 		"automationKind")
 	g.Expect(err).ToNot(HaveOccurred())
 
 	g.Expect(hl.Name).To(Equal("name"))
 	g.Expect(hl.Namespace).To(Equal("namespace"))
+	g.Expect(hl.Annotations).To(HaveKey(session.HeartbeatAnnotation))
 
 	values := map[string]interface{}{}
 	g.Expect(json.Unmarshal(hl.Spec.Values.Raw, &values)).ToNot(HaveOccurred())
@@ -27,6 +30,7 @@ func TestMakeVClusterHelmReleaseAnnotations(t *testing.T) {
 	annotations := values["annotations"].(map[string]interface{})
 	g.Expect(annotations["run.weave.works/automation-kind"]).To(Equal("automationKind"))
 	g.Expect(annotations["run.weave.works/namespace"]).To(Equal("namespace"))
-	g.Expect(annotations["run.weave.works/command"]).To(Equal("command"))
+	g.Expect(annotations["run.weave.works/command"]).To(Equal(`["gitops","beta","run","./my app","--timeout","3m"]`))
 	g.Expect(annotations["run.weave.works/port-forward"]).To(Equal("9999,1111"))
+	g.Expect(annotations[session.DashboardHashedPasswordAnnotation]).To(Equal("$2a$10$hash"))
 }
@@ -1,11 +1,14 @@
 package install
 
 import (
+	"context"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 
 	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/run/session"
 
 	vcluster "github.com/loft-sh/vcluster/cmd/vclusterctl/cmd"
 	"github.com/loft-sh/vcluster/cmd/vclusterctl/flags"
@@ -23,10 +26,13 @@ type Session struct {
 	dashboardHashedPassword string
 	portForwards            []string
 	automationKind          string
+	// args is the command line the session runs without a session, inside
+	// the vcluster.
+	args []string
 }
 
 func (s *Session) Start() error {
-	if err := installVCluster(s.kubeClient, s.name, s.namespace, s.fluxNamespace, s.portForwards, s.automationKind); err != nil {
+	if err := installVCluster(s.kubeClient, s.name, s.namespace, s.fluxNamespace, s.portForwards, s.dashboardHashedPassword, s.automationKind); err != nil {
 		return err
 	}
 
@@ -34,7 +40,7 @@ func (s *Session) Start() error {
 }
 
 func (s *Session) Connect() error {
-	subProcArgs := append(os.Args,
+	subProcArgs := append(s.args,
 		// we must run the sub-process without a session.
 		"--no-session",
 		// we must let the sub-run know that this is the session name of the sub-process
@@ -46,10 +52,13 @@ func (s *Session) Connect() error {
 		// we must skip resource cleanup in the sub-process because we are already deleting the vcluster.
 		// it's for optimization purposes.
 		"--skip-resource-cleanup",
-		// we forward dashboard password from host to session too.
-		"--dashboard-hashed-password="+s.dashboardHashedPassword,
 	)
 
+	if s.dashboardHashedPassword != "" {
+		// we forward dashboard password from host to session too.
+		subProcArgs = append(subProcArgs, "--dashboard-hashed-password="+s.dashboardHashedPassword)
+	}
+
 	connect := vcluster.ConnectCmd{
 		GlobalFlags: &flags.GlobalFlags{
 			// connect to the vcluster silently
@@ -62,6 +71,12 @@ func (s *Session) Connect() error {
 		KubeConfigContextName: s.name,
 	}
 
+	// keep the session from being removed as abandoned while it's connected
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	go session.KeepAlive(ctx, s.log, s.kubeClient, s.name, s.namespace, session.HeartbeatInterval)
+
 	c := make(chan os.Signal, 1)
 	signal.Notify(c, syscall.SIGINT, syscall.SIGTERM)
 
@@ -115,5 +130,30 @@ func NewSession(log logger.Logger, kubeClient client.Client, name string, namesp
 		portForwards:            portForwards,
 		dashboardHashedPassword: dashboardHashedPassword,
 		automationKind:          automationKind,
+		args:                    os.Args,
+	}, nil
+}
+
+// AttachSession returns the Session of a GitOps Run session that is already
+// running, so it can be connected to again. The command the session was
+// started with is run again in the current directory, with the dashboard
+// password the session was started with.
+func AttachSession(log logger.Logger, kubeClient client.Client, internalSession *session.InternalSession) (*Session, error) {
+	if len(internalSession.Args) == 0 {
+		return nil, fmt.Errorf("session %s/%s has no command to run, it may have been started by an older GitOps CLI", internalSession.SessionNamespace, internalSession.SessionName)
+	}
+
+	return &Session{
+		name:                    internalSession.SessionName,
+		namespace:               internalSession.SessionNamespace,
+		fluxNamespace:           internalSession.FluxNamespace,
+		kubeClient:              kubeClient,
+		log:                     log,
+		portForwards:            internalSession.PortForward,
+		automationKind:          internalSession.AutomationKind,
+		dashboardHashedPassword: internalSession.DashboardHashedPassword,
+		// the command is recorded with the base name of the binary, which
+		// may not be on the PATH, so run this binary instead.
+		args: append([]string{os.Args[0]}, internalSession.Args[1:]...),
 	}, nil
 }
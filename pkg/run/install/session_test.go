@@ -0,0 +1,38 @@
+package install
+
+import (
+	"os"
+	"testing"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/run/session"
+)
+
+func TestAttachSession(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	s, err := AttachSession(logger.NewCLILogger(os.Stdout), nil, &session.InternalSession{
+		SessionName:             "run-main-1234",
+		SessionNamespace:        "default",
+		Command:                 "gitops beta run ./deploy/overlays/my app --timeout 3m",
+		Args:                    []string{"gitops", "beta", "run", "./deploy/overlays/my app", "--timeout", "3m"},
+		PortForward:             []string{"9001"},
+		AutomationKind:          "ks",
+		FluxNamespace:           "flux-system",
+		DashboardHashedPassword: "$2a$10$hash",
+	})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	g.Expect(s.name).To(Equal("run-main-1234"))
+	g.Expect(s.namespace).To(Equal("default"))
+	g.Expect(s.fluxNamespace).To(Equal("flux-system"))
+	g.Expect(s.args).To(Equal([]string{os.Args[0], "beta", "run", "./deploy/overlays/my app", "--timeout", "3m"}))
+	g.Expect(s.dashboardHashedPassword).To(Equal("$2a$10$hash"))
+
+	_, err = AttachSession(logger.NewCLILogger(os.Stdout), nil, &session.InternalSession{
+		SessionName:      "broken",
+		SessionNamespace: "default",
+	})
+	g.Expect(err).To(MatchError("session default/broken has no command to run, it may have been started by an older GitOps CLI"))
+}
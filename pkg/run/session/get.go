@@ -3,7 +3,6 @@ package session
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
@@ -32,15 +31,7 @@ func Get(kubeClient client.Client, name string, namespace string) (*InternalSess
 		}
 	}
 
-	annotations := statefulSet.GetAnnotations()
-	result = &InternalSession{
-		SessionName:      statefulSet.Name,
-		SessionNamespace: statefulSet.Namespace,
-		Command:          annotations["run.weave.works/command"],
-		CliVersion:       annotations["run.weave.works/cli-version"],
-		PortForward:      strings.Split(annotations["run.weave.works/port-forward"], ","),
-		Namespace:        annotations["run.weave.works/namespace"],
-	}
+	result = fromStatefulSet(&statefulSet)
 
 	return result, nil
 }
@@ -25,10 +25,12 @@ func (m *mockGet) Get(_ context.Context, key client.ObjectKey, obj client.Object
 				Name:      key.Name,
 				Namespace: key.Namespace,
 				Annotations: map[string]string{
-					"run.weave.works/command":      "command",
-					"run.weave.works/cli-version":  "cli-version",
-					"run.weave.works/port-forward": "9999,1111",
-					"run.weave.works/namespace":    "flux-system",
+					"run.weave.works/command":         "command",
+					"run.weave.works/cli-version":     "cli-version",
+					"run.weave.works/port-forward":    "9999,1111",
+					"run.weave.works/namespace":       "flux-system",
+					"run.weave.works/automation-kind": "ks",
+					"run.weave.works/flux-namespace":  "flux-system",
 				},
 			},
 		}
@@ -48,4 +50,6 @@ func TestGet(t *testing.T) {
 	g.Expect(is.Command).To(Equal("command"))
 	g.Expect(is.CliVersion).To(Equal("cli-version"))
 	g.Expect(is.Namespace).To(Equal("flux-system"))
+	g.Expect(is.AutomationKind).To(Equal("ks"))
+	g.Expect(is.FluxNamespace).To(Equal("flux-system"))
 }
@@ -0,0 +1,120 @@
+package session
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// HeartbeatAnnotation records on the session HelmRelease when the CLI
+	// running the session was last seen alive.
+	HeartbeatAnnotation = "run.weave.works/heartbeat"
+
+	// HeartbeatInterval is how often the CLI refreshes the heartbeat while
+	// it's connected to the session.
+	HeartbeatInterval = time.Minute
+
+	// DefaultTTL is how long a session is kept after its last heartbeat. It's
+	// long enough for a laptop to sleep through lunch and attach again.
+	DefaultTTL = 4 * time.Hour
+)
+
+// Heartbeat records now as the last time the session was seen alive.
+func Heartbeat(ctx context.Context, kubeClient client.Client, name string, namespace string, now time.Time) error {
+	helmRelease := helmv2.HelmRelease{}
+	if err := kubeClient.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, &helmRelease); err != nil {
+		return err
+	}
+
+	patch := client.MergeFrom(helmRelease.DeepCopy())
+
+	annotations := helmRelease.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	annotations[HeartbeatAnnotation] = now.UTC().Format(time.RFC3339)
+	helmRelease.SetAnnotations(annotations)
+
+	return kubeClient.Patch(ctx, &helmRelease, patch)
+}
+
+// KeepAlive refreshes the heartbeat of the session every interval until the
+// context is done. Failures are reported, but don't stop the session, a
+// heartbeat can be missed as long as a later one makes it before the TTL.
+func KeepAlive(ctx context.Context, log logger.Logger, kubeClient client.Client, name string, namespace string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case now := <-ticker.C:
+			if err := Heartbeat(ctx, kubeClient, name, namespace, now); err != nil && ctx.Err() == nil {
+				log.Warningf("Failed to record heartbeat of session %s/%s: %v", namespace, name, err)
+			}
+		}
+	}
+}
+
+// ListExpired returns the sessions in the namespace whose last heartbeat is
+// older than the TTL. It looks at the HelmReleases rather than the vclusters,
+// so sessions whose vcluster never came up are found too.
+//
+// Sessions made by CLIs that don't record heartbeats, or whose heartbeat
+// can't be read, may still be in use, so they're only returned if
+// withoutHeartbeat is set and they were made longer than the TTL ago.
+func ListExpired(kubeClient client.Client, targetNamespace string, ttl time.Duration, now time.Time, withoutHeartbeat bool) ([]*InternalSession, error) {
+	var result []*InternalSession
+
+	helmReleases := helmv2.HelmReleaseList{}
+	if err := kubeClient.List(context.Background(), &helmReleases,
+		client.InNamespace(targetNamespace),
+		client.MatchingLabels(map[string]string{
+			"app":                       "vcluster",
+			"app.kubernetes.io/part-of": "gitops-run",
+		}),
+	); err != nil {
+		return nil, err
+	}
+
+	for i := range helmReleases.Items {
+		hr := &helmReleases.Items[i]
+
+		lastSeen, err := time.Parse(time.RFC3339, hr.GetAnnotations()[HeartbeatAnnotation])
+		if err != nil {
+			if !withoutHeartbeat {
+				continue
+			}
+
+			lastSeen = hr.CreationTimestamp.Time
+		}
+
+		if now.Sub(lastSeen) > ttl {
+			result = append(result, fromHelmRelease(hr))
+		}
+	}
+
+	return result, nil
+}
+
+// fromHelmRelease reads a session from the annotations its HelmRelease sets
+// on the vcluster StatefulSet, which may not exist yet.
+func fromHelmRelease(helmRelease *helmv2.HelmRelease) *InternalSession {
+	values := struct {
+		Annotations map[string]string `json:"annotations"`
+	}{}
+
+	if helmRelease.Spec.Values != nil {
+		// A session that can't be read can still be removed by name.
+		_ = json.Unmarshal(helmRelease.Spec.Values.Raw, &values)
+	}
+
+	return fromAnnotations(helmRelease.Name, helmRelease.Namespace, values.Annotations)
+}
@@ -0,0 +1,150 @@
+package session
+
+import (
+	"context"
+	"os"
+	"testing"
+	"time"
+
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func makeSessionHelmRelease(name string, created time.Time, annotations map[string]string) *helmv2.HelmRelease {
+	return &helmv2.HelmRelease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         "default",
+			CreationTimestamp: metav1.NewTime(created),
+			Labels: map[string]string{
+				"app":                       "vcluster",
+				"app.kubernetes.io/part-of": "gitops-run",
+			},
+			Annotations: annotations,
+		},
+	}
+}
+
+func TestHeartbeat(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(makeSessionHelmRelease("run-main", time.Now(), map[string]string{"run.weave.works/command": "gitops run ."})).
+		Build()
+
+	now := time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+	g.Expect(Heartbeat(context.Background(), kubeClient, "run-main", "default", now)).To(Succeed())
+
+	hr := helmv2.HelmRelease{}
+	g.Expect(kubeClient.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "run-main"}, &hr)).To(Succeed())
+	g.Expect(hr.Annotations).To(Equal(map[string]string{
+		"run.weave.works/command": "gitops run .",
+		HeartbeatAnnotation:       "2022-11-01T12:00:00Z",
+	}))
+
+	g.Expect(Heartbeat(context.Background(), kubeClient, "gone", "default", now)).NotTo(Succeed())
+}
+
+func TestKeepAliveStopsWithTheContext(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(makeSessionHelmRelease("run-main", time.Now(), nil)).
+		Build()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+
+	go func() {
+		KeepAlive(ctx, logger.NewCLILogger(os.Stdout), kubeClient, "run-main", "default", time.Millisecond)
+		close(done)
+	}()
+
+	g.Eventually(func() map[string]string {
+		hr := helmv2.HelmRelease{}
+		g.Expect(kubeClient.Get(context.Background(), client.ObjectKey{Namespace: "default", Name: "run-main"}, &hr)).To(Succeed())
+
+		return hr.Annotations
+	}).Should(HaveKey(HeartbeatAnnotation))
+
+	cancel()
+	g.Eventually(done).Should(BeClosed())
+}
+
+func TestListExpired(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	scheme, err := kube.CreateScheme()
+	g.Expect(err).NotTo(HaveOccurred())
+
+	now := time.Date(2022, 11, 1, 12, 0, 0, 0, time.UTC)
+
+	unrelated := makeSessionHelmRelease("podinfo", now.Add(-48*time.Hour), nil)
+	unrelated.Labels = nil
+
+	stale := makeSessionHelmRelease("stale", now.Add(-48*time.Hour), map[string]string{HeartbeatAnnotation: "2022-11-01T06:00:00Z"})
+	stale.Spec.Values = &apiextensionsv1.JSON{Raw: []byte(`{
+  "annotations": {
+    "run.weave.works/cli-version": "v0.12.0",
+    "run.weave.works/port-forward": "9999,1111",
+    "run.weave.works/command": "[\"gitops\",\"beta\",\"run\",\"./my app\"]",
+    "run.weave.works/automation-kind": "ks",
+    "run.weave.works/namespace": "default",
+    "run.weave.works/flux-namespace": "flux-system"
+  }
+}`)}
+
+	kubeClient := fake.NewClientBuilder().WithScheme(scheme).
+		WithObjects(
+			makeSessionHelmRelease("alive", now.Add(-48*time.Hour), map[string]string{HeartbeatAnnotation: "2022-11-01T11:59:00Z"}),
+			stale,
+			makeSessionHelmRelease("old-cli", now.Add(-48*time.Hour), nil),
+			makeSessionHelmRelease("new-old-cli", now.Add(-time.Hour), nil),
+			makeSessionHelmRelease("garbled", now.Add(-48*time.Hour), map[string]string{HeartbeatAnnotation: "yesterday"}),
+			unrelated,
+		).
+		Build()
+
+	names := func(sessions []*InternalSession) []string {
+		names := []string{}
+		for _, s := range sessions {
+			g.Expect(s.SessionNamespace).To(Equal("default"))
+			names = append(names, s.SessionName)
+		}
+
+		return names
+	}
+
+	expired, err := ListExpired(kubeClient, "default", 4*time.Hour, now, false)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(names(expired)).To(ConsistOf("stale"))
+
+	g.Expect(expired[0]).To(Equal(&InternalSession{
+		SessionName:      "stale",
+		SessionNamespace: "default",
+		PortForward:      []string{"9999", "1111"},
+		CliVersion:       "v0.12.0",
+		Command:          "gitops beta run ./my app",
+		Args:             []string{"gitops", "beta", "run", "./my app"},
+		Namespace:        "default",
+		AutomationKind:   "ks",
+		FluxNamespace:    "flux-system",
+	}))
+
+	// Sessions without a heartbeat are only removed when asked to.
+	expired, err = ListExpired(kubeClient, "default", 4*time.Hour, now, true)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(names(expired)).To(ConsistOf("stale", "old-cli", "garbled"))
+}
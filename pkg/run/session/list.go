@@ -2,7 +2,6 @@ package session
 
 import (
 	"context"
-	"strings"
 
 	appsv1 "k8s.io/api/apps/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -23,16 +22,7 @@ func List(kubeClient client.Client, targetNamespace string) ([]*InternalSession,
 	}
 
 	for _, s := range statefulSets.Items {
-		annotations := s.GetAnnotations()
-
-		result = append(result, &InternalSession{
-			SessionName:      s.Name,
-			SessionNamespace: s.Namespace,
-			Command:          annotations["run.weave.works/command"],
-			CliVersion:       annotations["run.weave.works/cli-version"],
-			PortForward:      strings.Split(annotations["run.weave.works/port-forward"], ","),
-			Namespace:        annotations["run.weave.works/namespace"],
-		})
+		result = append(result, fromStatefulSet(&s))
 	}
 
 	return result, nil
@@ -27,10 +27,13 @@ func (m *mockList) List(_ context.Context, list client.ObjectList, _ ...client.L
 						Name:      "name",
 						Namespace: "namespace",
 						Annotations: map[string]string{
-							"run.weave.works/command":      "command",
-							"run.weave.works/cli-version":  "cli-version",
-							"run.weave.works/port-forward": "9999,1111",
-							"run.weave.works/namespace":    "flux-system",
+							"run.weave.works/command":         `["gitops","beta","run","./my app"]`,
+							"run.weave.works/cli-version":     "cli-version",
+							"run.weave.works/port-forward":    "9999,1111",
+							"run.weave.works/namespace":       "flux-system",
+							"run.weave.works/automation-kind": "ks",
+							"run.weave.works/flux-namespace":  "flux-system",
+							DashboardHashedPasswordAnnotation: "$2a$10$hash",
 						},
 					},
 				},
@@ -50,7 +53,11 @@ func TestList(t *testing.T) {
 	g.Expect(list[0].SessionName).To(Equal("name"))
 	g.Expect(list[0].SessionNamespace).To(Equal("namespace"))
 	g.Expect(list[0].PortForward).To(Equal([]string{"9999", "1111"}))
-	g.Expect(list[0].Command).To(Equal("command"))
+	g.Expect(list[0].Command).To(Equal("gitops beta run ./my app"))
+	g.Expect(list[0].Args).To(Equal([]string{"gitops", "beta", "run", "./my app"}))
 	g.Expect(list[0].CliVersion).To(Equal("cli-version"))
 	g.Expect(list[0].Namespace).To(Equal("flux-system"))
+	g.Expect(list[0].AutomationKind).To(Equal("ks"))
+	g.Expect(list[0].FluxNamespace).To(Equal("flux-system"))
+	g.Expect(list[0].DashboardHashedPassword).To(Equal("$2a$10$hash"))
 }
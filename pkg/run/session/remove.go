@@ -2,7 +2,9 @@ package session
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"strings"
 	"time"
 
 	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
@@ -15,13 +17,58 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// CommandAnnotation records the command line a session was started with,
+// as a JSON array of its arguments.
+const CommandAnnotation = "run.weave.works/command"
+
+// DashboardHashedPasswordAnnotation records the bcrypt hash of the password
+// of the dashboard a session was started with.
+const DashboardHashedPasswordAnnotation = "run.weave.works/dashboard-hashed-password"
+
 type InternalSession struct {
-	SessionName      string
-	SessionNamespace string
-	PortForward      []string
-	CliVersion       string
-	Command          string
-	Namespace        string
+	SessionName      string   `json:"sessionName"`
+	SessionNamespace string   `json:"sessionNamespace"`
+	PortForward      []string `json:"portForward"`
+	CliVersion       string   `json:"cliVersion"`
+	Command          string   `json:"command"`
+	// Args is the command line the session was started with, it's empty
+	// for sessions started by CLIs that only recorded it as a string.
+	Args           []string `json:"args,omitempty"`
+	Namespace      string   `json:"namespace"`
+	AutomationKind string   `json:"automationKind"`
+	FluxNamespace  string   `json:"fluxNamespace"`
+	// DashboardHashedPassword is the bcrypt hash of the dashboard password,
+	// it's empty when the session was started without the dashboard.
+	DashboardHashedPassword string `json:"-"`
+}
+
+// fromStatefulSet reads a session from the annotations of its vcluster StatefulSet.
+func fromStatefulSet(statefulSet *appsv1.StatefulSet) *InternalSession {
+	return fromAnnotations(statefulSet.Name, statefulSet.Namespace, statefulSet.GetAnnotations())
+}
+
+// fromAnnotations reads a session from the annotations the vcluster chart
+// sets on the vcluster StatefulSet.
+func fromAnnotations(name string, namespace string, annotations map[string]string) *InternalSession {
+	command := annotations[CommandAnnotation]
+
+	var args []string
+	if err := json.Unmarshal([]byte(command), &args); err == nil {
+		command = strings.Join(args, " ")
+	}
+
+	return &InternalSession{
+		SessionName:             name,
+		SessionNamespace:        namespace,
+		Command:                 command,
+		Args:                    args,
+		CliVersion:              annotations["run.weave.works/cli-version"],
+		PortForward:             strings.Split(annotations["run.weave.works/port-forward"], ","),
+		Namespace:               annotations["run.weave.works/namespace"],
+		AutomationKind:          annotations["run.weave.works/automation-kind"],
+		FluxNamespace:           annotations["run.weave.works/flux-namespace"],
+		DashboardHashedPassword: annotations[DashboardHashedPasswordAnnotation],
+	}
 }
 
 func Remove(kubeClient client.Client, session *InternalSession) error {
@@ -99,7 +99,8 @@ func GetPodFromResourceDescription(ctx context.Context, namespacedName types.Nam
 		}
 
 		for _, pod := range podList.Items {
-			if pod.Status.Phase == corev1.PodRunning {
+			// skip pods that are being replaced, they're about to go away
+			if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil {
 				return &pod, nil
 			}
 		}
@@ -127,7 +128,8 @@ func GetPodFromResourceDescription(ctx context.Context, namespacedName types.Nam
 		}
 
 		for _, pod := range podList.Items {
-			if pod.Status.Phase == corev1.PodRunning {
+			// skip pods that are being replaced, they're about to go away
+			if pod.Status.Phase == corev1.PodRunning && pod.DeletionTimestamp == nil {
 				return &pod, nil
 			}
 		}
@@ -8,16 +8,20 @@ import (
 	"net/url"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/mattn/go-tty"
 	"github.com/pkg/browser"
 	"github.com/weaveworks/weave-gitops/core/logger"
 	clilogger "github.com/weaveworks/weave-gitops/pkg/logger"
+	"github.com/weaveworks/weave-gitops/pkg/run"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/portforward"
 	"k8s.io/client-go/transport/spdy"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 type PortForwardSpec struct {
@@ -26,7 +30,19 @@ type PortForwardSpec struct {
 	Kind          string
 	HostPort      string
 	ContainerPort string
-	Map           map[string]string
+	// ForwardName is what the forward is shown as, set with the "name" key.
+	ForwardName string
+	Map         map[string]string
+}
+
+// DisplayName returns the name the forward is shown with, which is the name
+// of the resource unless the forward was named.
+func (s *PortForwardSpec) DisplayName() string {
+	if s.ForwardName != "" {
+		return s.ForwardName
+	}
+
+	return s.Name
 }
 
 type PortForwardShortcut struct {
@@ -36,7 +52,7 @@ type PortForwardShortcut struct {
 
 var PortForwardShortcutRunes = []rune{'0', '1', '2', '3', '4', '5', '6', '7', '8', '9'}
 
-// parse port forward specin the key-value format of "port=8000:8080,resource=svc/app,namespace=default"
+// parse port forward specin the key-value format of "port=8000:8080,resource=svc/app,namespace=default,name=app"
 func ParsePortForwardSpec(spec string) (*PortForwardSpec, error) {
 	specMap := PortForwardSpec{
 		Map: make(map[string]string),
@@ -50,10 +66,14 @@ func ParsePortForwardSpec(spec string) (*PortForwardSpec, error) {
 		}
 
 		if kv[0] == "port" {
-			// split into port and host port
+			// split into port and host port, a single port is used for both
 			portAndHostPort := strings.Split(kv[1], ":")
+			if len(portAndHostPort) > 2 || portAndHostPort[0] == "" {
+				return nil, fmt.Errorf("invalid port: %s", kv[1])
+			}
+
 			specMap.HostPort = portAndHostPort[0]
-			specMap.ContainerPort = portAndHostPort[1]
+			specMap.ContainerPort = portAndHostPort[len(portAndHostPort)-1]
 		} else if kv[0] == "resource" {
 			// specMap["resource"] = kv[1]
 			// split kv[1] into kind and name
@@ -65,14 +85,70 @@ func ParsePortForwardSpec(spec string) (*PortForwardSpec, error) {
 			specMap.Name = kindAndName[1]
 		} else if kv[0] == "namespace" {
 			specMap.Namespace = kv[1]
+		} else if kv[0] == "name" {
+			specMap.ForwardName = kv[1]
 		} else {
 			specMap.Map[kv[0]] = kv[1]
 		}
 	}
 
+	if specMap.HostPort == "" || specMap.Name == "" {
+		return nil, fmt.Errorf("invalid port forward spec, port and resource are required: %s", spec)
+	}
+
 	return &specMap, nil
 }
 
+// ParsePortForwardSpecs parses the port forwards given with --port-forward,
+// followed by the ones in the configuration file. Two forwards can't use the
+// same host port, except that a forward in the configuration file is left
+// out when a --port-forward uses its host port, as flags override the file.
+func ParsePortForwardSpecs(specs []string, config *run.Config) ([]*PortForwardSpec, error) {
+	result := []*PortForwardSpec{}
+	hostPorts := map[string]string{}
+	flagHostPorts := map[string]bool{}
+
+	add := func(spec string) error {
+		specMap, err := ParsePortForwardSpec(spec)
+		if err != nil {
+			return err
+		}
+
+		if other, ok := hostPorts[specMap.HostPort]; ok {
+			return fmt.Errorf("port forwards %s and %s both use host port %s", other, specMap.DisplayName(), specMap.HostPort)
+		}
+
+		hostPorts[specMap.HostPort] = specMap.DisplayName()
+
+		result = append(result, specMap)
+
+		return nil
+	}
+
+	for _, spec := range specs {
+		if err := add(spec); err != nil {
+			return nil, err
+		}
+	}
+
+	for hostPort := range hostPorts {
+		flagHostPorts[hostPort] = true
+	}
+
+	for _, forward := range config.PortForwards {
+		hostPort, _, _ := strings.Cut(forward.Port, ":")
+		if flagHostPorts[hostPort] {
+			continue
+		}
+
+		if err := add(forward.Spec()); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
 func generalizeKind(kind string) string {
 	// switch over kind
 	switch kind {
@@ -139,6 +215,71 @@ func ForwardPort(log logr.Logger, pod *corev1.Pod, cfg *rest.Config, specMap *Po
 	return fw.ForwardPorts()
 }
 
+// KeepPortForwarding forwards the port of the spec to a pod of its resource
+// until the context is done. When the pod goes away, for example because a
+// rollout replaced it, the port is forwarded to one of the new pods.
+func KeepPortForwarding(ctx context.Context, log clilogger.Logger, kubeClient client.Client, cfg *rest.Config, specMap *PortForwardSpec, retryInterval time.Duration) {
+	namespacedName := types.NamespacedName{Namespace: specMap.Namespace, Name: specMap.Name}
+
+	// only report an error once, until it changes, so a forward that can't be
+	// set up doesn't flood the logs while it's retried.
+	lastErr := ""
+	report := func(format string, err error) {
+		if err.Error() != lastErr {
+			log.Failuref(format, specMap.DisplayName(), err)
+			lastErr = err.Error()
+		}
+	}
+
+	for {
+		pod, err := run.GetPodFromResourceDescription(ctx, namespacedName, specMap.Kind, kubeClient)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+
+			report("Waiting for a pod to forward %s to: %v", err)
+		} else {
+			waitFwd := make(chan struct{})
+			readyChannel := make(chan struct{})
+			done := make(chan struct{})
+
+			go func() {
+				select {
+				case <-ctx.Done():
+					close(waitFwd)
+				case <-done:
+				}
+			}()
+
+			log.Actionf("Port forwarding %s to pod %s/%s ...", specMap.DisplayName(), pod.Namespace, pod.Name)
+
+			// this _BLOCKS_ until the context is done, or the connection to the pod is lost.
+			err := ForwardPort(log.L(), pod, cfg, specMap, waitFwd, readyChannel)
+			close(done)
+
+			if ctx.Err() != nil {
+				log.Successf("Port forwarding %s is stopped.", specMap.DisplayName())
+				return
+			}
+
+			if err != nil {
+				report("Error forwarding port of %s: %v", err)
+			} else {
+				lastErr = ""
+
+				log.Warningf("Lost the connection to pod %s/%s, port forwarding %s again ...", pod.Namespace, pod.Name, specMap.DisplayName())
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(retryInterval):
+		}
+	}
+}
+
 func ShowPortForwards(ctx context.Context, log clilogger.Logger, portForwards map[rune]PortForwardShortcut) {
 	// print keyboard shortcuts
 	// print text in bold
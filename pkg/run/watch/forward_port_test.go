@@ -0,0 +1,87 @@
+package watch
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/weave-gitops/pkg/run"
+)
+
+var _ = Describe("ParsePortForwardSpec", func() {
+	It("parses a named port forward", func() {
+		spec, err := ParsePortForwardSpec("port=8080:80,resource=svc/frontend,namespace=dev,name=web")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(spec.Namespace).To(Equal("dev"))
+		Expect(spec.Kind).To(Equal("service"))
+		Expect(spec.Name).To(Equal("frontend"))
+		Expect(spec.HostPort).To(Equal("8080"))
+		Expect(spec.ContainerPort).To(Equal("80"))
+		Expect(spec.DisplayName()).To(Equal("web"))
+	})
+
+	It("uses a single port as both the host and the container port", func() {
+		spec, err := ParsePortForwardSpec("port=9898,resource=deployment/backend")
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(spec.Namespace).To(Equal("default"))
+		Expect(spec.HostPort).To(Equal("9898"))
+		Expect(spec.ContainerPort).To(Equal("9898"))
+		Expect(spec.DisplayName()).To(Equal("backend"))
+	})
+
+	It("requires a port and a resource", func() {
+		_, err := ParsePortForwardSpec("resource=svc/frontend")
+		Expect(err).To(HaveOccurred())
+
+		_, err = ParsePortForwardSpec("port=8080:80")
+		Expect(err).To(HaveOccurred())
+
+		_, err = ParsePortForwardSpec("port=8080:80:70,resource=svc/frontend")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
+var _ = Describe("ParsePortForwardSpecs", func() {
+	It("adds the port forwards of the config file after the flags", func() {
+		specs, err := ParsePortForwardSpecs(
+			[]string{"port=8080:80,resource=svc/frontend"},
+			&run.Config{PortForwards: []run.PortForwardConfig{{Name: "api", Resource: "svc/backend", Port: "9898:9898"}}},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(specs).To(HaveLen(2))
+		Expect(specs[0].DisplayName()).To(Equal("frontend"))
+		Expect(specs[1].DisplayName()).To(Equal("api"))
+	})
+
+	It("doesn't allow two port forwards on the same host port", func() {
+		_, err := ParsePortForwardSpecs(
+			[]string{"port=8080:80,resource=svc/frontend", "port=8080:9898,resource=svc/backend"},
+			&run.Config{},
+		)
+		Expect(err).To(MatchError("port forwards frontend and backend both use host port 8080"))
+
+		_, err = ParsePortForwardSpecs(nil, &run.Config{PortForwards: []run.PortForwardConfig{
+			{Resource: "svc/frontend", Port: "8080:80"},
+			{Resource: "svc/backend", Port: "8080:9898"},
+		}})
+		Expect(err).To(MatchError("port forwards frontend and backend both use host port 8080"))
+	})
+
+	It("leaves out the port forwards of the config file that use the host port of a flag", func() {
+		specs, err := ParsePortForwardSpecs(
+			[]string{"port=8080:80,resource=svc/frontend"},
+			&run.Config{PortForwards: []run.PortForwardConfig{
+				{Resource: "svc/backend", Port: "8080:9898"},
+				{Resource: "svc/metrics", Port: "9090"},
+			}},
+		)
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(specs).To(HaveLen(2))
+		Expect(specs[0].DisplayName()).To(Equal("frontend"))
+		Expect(specs[0].ContainerPort).To(Equal("80"))
+		Expect(specs[1].DisplayName()).To(Equal("metrics"))
+	})
+})
@@ -14,7 +14,6 @@ import (
 	"github.com/fluxcd/pkg/apis/meta"
 	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
 	"github.com/fsnotify/fsnotify"
-	"github.com/minio/minio-go/v7"
 	ignore "github.com/sabhiram/go-gitignore"
 	"github.com/weaveworks/weave-gitops/pkg/logger"
 	"github.com/weaveworks/weave-gitops/pkg/run"
@@ -140,82 +139,6 @@ func setupDecryption(ctx context.Context, params SetupRunObjectParams, kubeClien
 	return nil
 }
 
-// SyncDir recursively uploads all files in a directory to an S3 bucket with minio library
-func SyncDir(ctx context.Context, log logger.Logger, dir string, bucket string, client *minio.Client, ignorer *ignore.GitIgnore) error {
-	log.Actionf("Refreshing bucket %s ...", bucket)
-
-	if err := client.RemoveBucketWithOptions(ctx, bucket, minio.RemoveBucketOptions{
-		ForceDelete: true,
-	}); err != nil {
-		// if error is not bucket not found, return error
-		if !strings.Contains(err.Error(), "NoSuchBucket") {
-			return err
-		}
-	}
-
-	if err := client.MakeBucket(ctx, bucket, minio.MakeBucketOptions{}); err != nil {
-		return err
-	}
-
-	uploadCount := 0
-	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
-		if err != nil {
-			log.Failuref("Error walking directory: %v", err)
-			return err
-		}
-
-		if info.IsDir() {
-			// if it's a hidden directory, ignore it
-			if strings.HasPrefix(info.Name(), ".") {
-				return filepath.SkipDir
-			}
-
-			return nil
-		}
-
-		objectName, err := filepath.Rel(dir, path)
-		if err != nil {
-			log.Failuref("Error getting relative path: %v", err)
-			return err
-		}
-		if ignorer.MatchesPath(path) {
-			return nil
-		}
-		// upload the file
-		_, err = client.FPutObject(ctx, bucket, objectName, path, minio.PutObjectOptions{})
-
-		if err != nil {
-			if errors.Is(err, context.Canceled) {
-				return err
-			}
-			errResp, ok := err.(minio.ErrorResponse)
-			if ok && errResp.Code == "MissingContentLength" {
-				// This happens when the file was empty - this is OK
-				return nil
-			}
-			// Report the error, but continue anyway - this could be e.g.
-			// a file with odd permissions, which isn't necessarily a problem
-			log.Failuref("Couldn't upload %v: %v", path, err)
-			return nil
-		}
-		uploadCount = uploadCount + 1
-		if uploadCount%10 == 0 {
-			fmt.Print(".")
-		}
-		return nil
-	})
-
-	fmt.Println()
-	log.Actionf("Uploaded %d files", uploadCount)
-
-	if err != nil && !errors.Is(err, context.Canceled) {
-		log.Failuref("Error syncing directory: %v", err)
-		return err
-	}
-
-	return nil
-}
-
 // CleanupBucketSourceAndKS removes the bucket source and ks
 func CleanupBucketSourceAndKS(ctx context.Context, log logger.Logger, kubeClient client.Client, namespace string) error {
 	// delete ks
@@ -482,12 +405,17 @@ func ReconcileDevBucketSourceAndKS(ctx context.Context, log logger.Logger, kubeC
 	return devKsErr
 }
 
-func CreateIgnorer(gitRootDir string) *ignore.GitIgnore {
+// CreateIgnorer returns an ignorer for the .gitignore in the root directory,
+// with the extra patterns added.
+func CreateIgnorer(gitRootDir string, extraPatterns ...string) *ignore.GitIgnore {
 	ignoreFile := filepath.Join(gitRootDir, ".gitignore")
 
+	// The GitOps Run configuration isn't a manifest, so it's never synced.
+	patterns := append([]string{run.ConfigFileName}, extraPatterns...)
+
 	var ignorer *ignore.GitIgnore = nil
 	if _, err := os.Stat(ignoreFile); err == nil {
-		ignorer, err = ignore.CompileIgnoreFile(ignoreFile)
+		ignorer, err = ignore.CompileIgnoreFileAndLines(ignoreFile, patterns...)
 		if err != nil {
 			// If we couldn't parse gitignore, just ignore nothing
 			ignorer = nil
@@ -496,8 +424,8 @@ func CreateIgnorer(gitRootDir string) *ignore.GitIgnore {
 
 	if ignorer == nil {
 		// Whether there was no gitignore file or the one that was there was broken,
-		// fall back to just no ignore lines - this is just a pass-through
-		ignorer = ignore.CompileIgnoreLines()
+		// fall back to ignoring only the extra patterns
+		ignorer = ignore.CompileIgnoreLines(patterns...)
 	}
 
 	return ignorer
@@ -359,11 +359,21 @@ var _ = Describe("CreateIgnorer", func() {
 		Expect(ignorer.MatchesPath("pkg/server")).To(Equal(false))
 		Expect(ignorer.MatchesPath("temp~")).To(Equal(true))
 		Expect(ignorer.MatchesPath("bin/gitops")).To(Equal(true))
+		Expect(ignorer.MatchesPath("apps/dev/.gitops-run.yaml")).To(Equal(true))
 	})
 	It("doesn't mind no gitignore", func() {
 		str, err := filepath.Abs(".")
 		Expect(err).ToNot(HaveOccurred())
 		ignorer := CreateIgnorer(str)
 		Expect(ignorer.MatchesPath("bin/gitops")).To(Equal(false))
+		Expect(ignorer.MatchesPath(".gitops-run.yaml")).To(Equal(true))
+	})
+	It("adds the extra patterns", func() {
+		str, err := filepath.Abs(".")
+		Expect(err).ToNot(HaveOccurred())
+		ignorer := CreateIgnorer(str, "*.md", "docs/")
+		Expect(ignorer.MatchesPath("apps/README.md")).To(Equal(true))
+		Expect(ignorer.MatchesPath("docs/deployment.yaml")).To(Equal(true))
+		Expect(ignorer.MatchesPath("apps/deployment.yaml")).To(Equal(false))
 	})
 })
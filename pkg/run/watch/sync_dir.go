@@ -0,0 +1,389 @@
+package watch
+
+import (
+	"context"
+	"crypto/md5"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/minio/minio-go/v7"
+	ignore "github.com/sabhiram/go-gitignore"
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+)
+
+// bucketClient is the part of the minio client the DirSyncer uses.
+type bucketClient interface {
+	BucketExists(ctx context.Context, bucketName string) (bool, error)
+	MakeBucket(ctx context.Context, bucketName string, opts minio.MakeBucketOptions) error
+	ListObjects(ctx context.Context, bucketName string, opts minio.ListObjectsOptions) <-chan minio.ObjectInfo
+	FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts minio.PutObjectOptions) (minio.UploadInfo, error)
+	RemoveObjects(ctx context.Context, bucketName string, objectsCh <-chan minio.ObjectInfo, opts minio.RemoveObjectsOptions) <-chan minio.RemoveObjectError
+}
+
+// localFile is a file in the synced directory, with the hash of its content.
+type localFile struct {
+	path    string
+	size    int64
+	modTime time.Time
+	hash    string
+}
+
+// DirSyncer keeps a bucket in sync with a directory. It keeps a manifest of
+// the content hash of every object in the bucket, so that each sync only
+// uploads the files that changed, and deletes the files that were removed.
+//
+// The hashes are MD5s, which is what S3 uses as the ETag of objects uploaded
+// in one part, so the manifest can be read back from the bucket on the first
+// sync.
+type DirSyncer struct {
+	log     logger.Logger
+	dir     string
+	bucket  string
+	client  bucketClient
+	ignorer *ignore.GitIgnore
+
+	// manifest maps the objects in the bucket to the hash of their content,
+	// it's read from the bucket again whenever it's empty.
+	manifest map[string]string
+	// files caches the hashes of local files by name, so files that haven't
+	// been modified since the last sync aren't read again.
+	files map[string]localFile
+}
+
+// NewDirSyncer creates a DirSyncer that syncs the files in dir that aren't
+// ignored to the bucket.
+func NewDirSyncer(log logger.Logger, dir string, bucket string, client *minio.Client, ignorer *ignore.GitIgnore) *DirSyncer {
+	return &DirSyncer{
+		log:     log,
+		dir:     dir,
+		bucket:  bucket,
+		client:  client,
+		ignorer: ignorer,
+		files:   map[string]localFile{},
+	}
+}
+
+// Sync uploads the files that changed since the last sync, and deletes the
+// objects whose files were removed. Files that can't be uploaded are
+// reported and tried again on the next sync.
+//
+// The dev bucket keeps its objects in memory, so a restart of its pod loses
+// the bucket. When that happens the bucket is made again and every file is
+// uploaded.
+func (s *DirSyncer) Sync(ctx context.Context) error {
+	err := s.sync(ctx)
+	if isNoSuchBucket(err) {
+		s.log.Warningf("Bucket %s is gone, uploading every file again", s.bucket)
+
+		s.manifest = nil
+		err = s.sync(ctx)
+	}
+
+	return err
+}
+
+func (s *DirSyncer) sync(ctx context.Context) error {
+	if err := s.checkBucket(ctx); err != nil {
+		return fmt.Errorf("failed reading bucket %s: %w", s.bucket, err)
+	}
+
+	files, err := s.scan(ctx)
+	if err != nil {
+		s.log.Failuref("Error walking directory: %v", err)
+		return err
+	}
+
+	s.files = files
+
+	uploaded := 0
+
+	for name, f := range files {
+		if s.manifest[name] == f.hash {
+			continue
+		}
+
+		if err := s.upload(ctx, name, f); err != nil {
+			if errors.Is(err, context.Canceled) || isNoSuchBucket(err) {
+				return err
+			}
+
+			// Report the error, but continue anyway - this could be e.g.
+			// a file with odd permissions, which isn't necessarily a problem
+			s.log.Failuref("Couldn't upload %v: %v", f.path, err)
+
+			continue
+		}
+
+		s.manifest[name] = f.hash
+		uploaded++
+	}
+
+	deleted, err := s.deleteRemoved(ctx, files)
+	if err != nil {
+		return err
+	}
+
+	if uploaded > 0 || deleted > 0 {
+		s.log.Actionf("Bucket %s synced: uploaded %d files, deleted %d files", s.bucket, uploaded, deleted)
+	}
+
+	return nil
+}
+
+// checkBucket makes the bucket if it doesn't exist, forgetting what was
+// uploaded to it before, and reads the hashes of the objects already in it
+// when there's nothing in the manifest.
+func (s *DirSyncer) checkBucket(ctx context.Context) error {
+	exists, err := s.client.BucketExists(ctx, s.bucket)
+	if err != nil {
+		return err
+	}
+
+	if !exists {
+		if len(s.manifest) > 0 {
+			s.log.Warningf("Bucket %s is gone, uploading every file again", s.bucket)
+		}
+
+		if err := s.client.MakeBucket(ctx, s.bucket, minio.MakeBucketOptions{}); err != nil {
+			return err
+		}
+
+		s.manifest = map[string]string{}
+
+		return nil
+	}
+
+	if len(s.manifest) > 0 {
+		return nil
+	}
+
+	manifest := map[string]string{}
+
+	for obj := range s.client.ListObjects(ctx, s.bucket, minio.ListObjectsOptions{Recursive: true}) {
+		if obj.Err != nil {
+			return obj.Err
+		}
+
+		manifest[obj.Key] = strings.Trim(obj.ETag, `"`)
+	}
+
+	s.manifest = manifest
+
+	return nil
+}
+
+// scan returns the files in the directory that aren't ignored, by object
+// name. Only the files that were modified since the last scan are hashed.
+func (s *DirSyncer) scan(ctx context.Context) (map[string]localFile, error) {
+	files := map[string]localFile{}
+
+	err := filepath.Walk(s.dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		if info.IsDir() {
+			// if it's a hidden directory, ignore it
+			if strings.HasPrefix(info.Name(), ".") && path != s.dir {
+				return filepath.SkipDir
+			}
+
+			if s.ignorer.MatchesPath(path) && path != s.dir {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		if !info.Mode().IsRegular() || s.ignorer.MatchesPath(path) {
+			return nil
+		}
+
+		name, err := filepath.Rel(s.dir, path)
+		if err != nil {
+			return err
+		}
+
+		name = filepath.ToSlash(name)
+
+		if cached, ok := s.files[name]; ok && cached.size == info.Size() && cached.modTime.Equal(info.ModTime()) {
+			files[name] = cached
+			return nil
+		}
+
+		hash, err := hashFile(path)
+		if err != nil {
+			// The file may have been removed since the walk found it.
+			s.log.Warningf("Couldn't read %v: %v", path, err)
+			return nil
+		}
+
+		files[name] = localFile{path: path, size: info.Size(), modTime: info.ModTime(), hash: hash}
+
+		return nil
+	})
+
+	return files, err
+}
+
+func (s *DirSyncer) upload(ctx context.Context, name string, f localFile) error {
+	_, err := s.client.FPutObject(ctx, s.bucket, name, f.path, minio.PutObjectOptions{})
+	if err != nil {
+		errResp, ok := err.(minio.ErrorResponse)
+		if ok && errResp.Code == "MissingContentLength" {
+			// This happens when the file was empty - this is OK
+			return nil
+		}
+
+		return err
+	}
+
+	return nil
+}
+
+// deleteRemoved deletes the objects whose files are gone, and returns how
+// many were deleted.
+func (s *DirSyncer) deleteRemoved(ctx context.Context, files map[string]localFile) (int, error) {
+	removed := []string{}
+
+	for name := range s.manifest {
+		if _, ok := files[name]; !ok {
+			removed = append(removed, name)
+		}
+	}
+
+	if len(removed) == 0 {
+		return 0, nil
+	}
+
+	objects := make(chan minio.ObjectInfo, len(removed))
+	for _, name := range removed {
+		objects <- minio.ObjectInfo{Key: name}
+	}
+
+	close(objects)
+
+	failed := map[string]bool{}
+
+	for err := range s.client.RemoveObjects(ctx, s.bucket, objects, minio.RemoveObjectsOptions{}) {
+		if errors.Is(err.Err, context.Canceled) || isNoSuchBucket(err.Err) {
+			return 0, err.Err
+		}
+
+		s.log.Failuref("Couldn't delete %v: %v", err.ObjectName, err.Err)
+		failed[err.ObjectName] = true
+	}
+
+	deleted := 0
+
+	for _, name := range removed {
+		if !failed[name] {
+			delete(s.manifest, name)
+			deleted++
+		}
+	}
+
+	return deleted, nil
+}
+
+func isNoSuchBucket(err error) bool {
+	var errResp minio.ErrorResponse
+
+	return errors.As(err, &errResp) && errResp.Code == "NoSuchBucket"
+}
+
+func hashFile(path string) (string, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return "", err
+	}
+	defer f.Close()
+
+	h := md5.New()
+	if _, err := io.Copy(h, f); err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(h.Sum(nil)), nil
+}
+
+// BatchEvents sends the file change events in batches, once no event has
+// arrived for the quiet period, or the oldest event in the batch has waited
+// for maxWait. That way a burst of changes, like a git checkout, is synced
+// once. Events for ignored files are dropped. The batches channel is closed
+// when the context is done, or the events channel is closed.
+func BatchEvents(ctx context.Context, events <-chan fsnotify.Event, ignorer *ignore.GitIgnore, quiet, maxWait time.Duration) <-chan []fsnotify.Event {
+	batches := make(chan []fsnotify.Event)
+
+	go func() {
+		defer close(batches)
+
+		var (
+			batch    []fsnotify.Event
+			deadline time.Time
+		)
+
+		timer := time.NewTimer(quiet)
+		timer.Stop()
+
+		resetTimer := func(d time.Duration) {
+			if !timer.Stop() {
+				select {
+				case <-timer.C:
+				default:
+				}
+			}
+
+			timer.Reset(d)
+		}
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case event, ok := <-events:
+				if !ok {
+					return
+				}
+
+				if ignorer.MatchesPath(event.Name) {
+					continue
+				}
+
+				if len(batch) == 0 {
+					deadline = time.Now().Add(maxWait)
+				}
+
+				batch = append(batch, event)
+
+				wait := quiet
+				if untilDeadline := time.Until(deadline); untilDeadline < wait {
+					wait = untilDeadline
+				}
+
+				resetTimer(wait)
+			case <-timer.C:
+				select {
+				case batches <- batch:
+				case <-ctx.Done():
+					return
+				}
+
+				batch = nil
+			}
+		}
+	}()
+
+	return batches
+}
@@ -0,0 +1,216 @@
+package watch
+
+import (
+	"context"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+	"github.com/johannesboyne/gofakes3"
+	"github.com/johannesboyne/gofakes3/backend/s3mem"
+	"github.com/minio/minio-go/v7"
+	"github.com/minio/minio-go/v7/pkg/credentials"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ignore "github.com/sabhiram/go-gitignore"
+
+	"github.com/weaveworks/weave-gitops/pkg/logger"
+)
+
+// countingBucketClient counts the uploads made through it.
+type countingBucketClient struct {
+	bucketClient
+	uploads []string
+	// staleExists is how many more times the bucket is reported to exist
+	// whether or not it does.
+	staleExists int
+}
+
+func (c *countingBucketClient) BucketExists(ctx context.Context, bucketName string) (bool, error) {
+	if c.staleExists > 0 {
+		c.staleExists--
+		return true, nil
+	}
+
+	return c.bucketClient.BucketExists(ctx, bucketName)
+}
+
+func (c *countingBucketClient) FPutObject(ctx context.Context, bucketName, objectName, filePath string, opts minio.PutObjectOptions) (minio.UploadInfo, error) {
+	c.uploads = append(c.uploads, objectName)
+	return c.bucketClient.FPutObject(ctx, bucketName, objectName, filePath, opts)
+}
+
+var _ = Describe("DirSyncer", func() {
+	var (
+		ctx         context.Context
+		dir         string
+		minioClient *minio.Client
+		client      *countingBucketClient
+		syncer      *DirSyncer
+	)
+
+	writeFile := func(name, content string) {
+		path := filepath.Join(dir, name)
+		Expect(os.MkdirAll(filepath.Dir(path), 0755)).To(Succeed())
+		Expect(os.WriteFile(path, []byte(content), 0644)).To(Succeed())
+	}
+
+	bucketObjects := func() []string {
+		names := []string{}
+
+		for obj := range minioClient.ListObjects(ctx, "bucket", minio.ListObjectsOptions{Recursive: true}) {
+			Expect(obj.Err).NotTo(HaveOccurred())
+			names = append(names, obj.Key)
+		}
+
+		sort.Strings(names)
+
+		return names
+	}
+
+	BeforeEach(func() {
+		ctx = context.Background()
+		dir = GinkgoT().TempDir()
+
+		server := httptest.NewServer(gofakes3.New(s3mem.New()).Server())
+		DeferCleanup(server.Close)
+
+		serverURL, err := url.Parse(server.URL)
+		Expect(err).NotTo(HaveOccurred())
+
+		minioClient, err = minio.New(serverURL.Host, &minio.Options{
+			Creds: credentials.NewStaticV4("user", "secret", ""),
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		writeFile("app/deployment.yaml", "kind: Deployment")
+		writeFile("app/service.yaml", "kind: Service")
+		writeFile("build/output.log", "ignored")
+		writeFile(".hidden/config", "ignored")
+
+		client = &countingBucketClient{bucketClient: minioClient}
+		syncer = NewDirSyncer(logger.NewCLILogger(GinkgoWriter), dir, "bucket", nil, ignore.CompileIgnoreLines("build/"))
+		syncer.client = client
+	})
+
+	It("makes the bucket and uploads every file that isn't ignored", func() {
+		Expect(syncer.Sync(ctx)).To(Succeed())
+
+		Expect(bucketObjects()).To(Equal([]string{"app/deployment.yaml", "app/service.yaml"}))
+	})
+
+	It("only uploads the files that changed, and deletes removed files", func() {
+		Expect(syncer.Sync(ctx)).To(Succeed())
+
+		client.uploads = nil
+
+		writeFile("app/service.yaml", "kind: Service\nspec: {}")
+		writeFile("app/configmap.yaml", "kind: ConfigMap")
+		Expect(os.Remove(filepath.Join(dir, "app/deployment.yaml"))).To(Succeed())
+
+		Expect(syncer.Sync(ctx)).To(Succeed())
+
+		Expect(client.uploads).To(ConsistOf("app/service.yaml", "app/configmap.yaml"))
+		Expect(bucketObjects()).To(Equal([]string{"app/configmap.yaml", "app/service.yaml"}))
+
+		client.uploads = nil
+
+		Expect(syncer.Sync(ctx)).To(Succeed())
+		Expect(client.uploads).To(BeEmpty())
+	})
+
+	It("reads what's already in the bucket on the first sync", func() {
+		Expect(syncer.Sync(ctx)).To(Succeed())
+
+		writeFile("app/service.yaml", "kind: Service\nspec: {}")
+
+		restarted := &countingBucketClient{bucketClient: minioClient}
+		syncer = NewDirSyncer(logger.NewCLILogger(GinkgoWriter), dir, "bucket", nil, ignore.CompileIgnoreLines("build/"))
+		syncer.client = restarted
+
+		Expect(syncer.Sync(ctx)).To(Succeed())
+		Expect(restarted.uploads).To(Equal([]string{"app/service.yaml"}))
+	})
+
+	Context("when the dev bucket is restarted and loses the bucket", func() {
+		BeforeEach(func() {
+			Expect(syncer.Sync(ctx)).To(Succeed())
+
+			for _, name := range bucketObjects() {
+				Expect(minioClient.RemoveObject(ctx, "bucket", name, minio.RemoveObjectOptions{})).To(Succeed())
+			}
+
+			Expect(minioClient.RemoveBucket(ctx, "bucket")).To(Succeed())
+
+			client.uploads = nil
+		})
+
+		It("makes the bucket again and uploads every file", func() {
+			Expect(syncer.Sync(ctx)).To(Succeed())
+
+			Expect(client.uploads).To(ConsistOf("app/deployment.yaml", "app/service.yaml"))
+			Expect(bucketObjects()).To(Equal([]string{"app/deployment.yaml", "app/service.yaml"}))
+		})
+
+		It("uploads every file when an upload finds the bucket gone", func() {
+			client.staleExists = 1
+
+			writeFile("app/service.yaml", "kind: Service\nspec: {}")
+
+			Expect(syncer.Sync(ctx)).To(Succeed())
+
+			Expect(client.uploads).To(ConsistOf("app/service.yaml", "app/deployment.yaml", "app/service.yaml"))
+			Expect(bucketObjects()).To(Equal([]string{"app/deployment.yaml", "app/service.yaml"}))
+		})
+	})
+})
+
+var _ = Describe("BatchEvents", func() {
+	It("sends a burst of events as one batch, leaving out ignored files", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events := make(chan fsnotify.Event)
+		batches := BatchEvents(ctx, events, ignore.CompileIgnoreLines("*.log"), 100*time.Millisecond, time.Second)
+
+		events <- fsnotify.Event{Name: "a.yaml", Op: fsnotify.Write}
+		events <- fsnotify.Event{Name: "build.log", Op: fsnotify.Write}
+		events <- fsnotify.Event{Name: "b.yaml", Op: fsnotify.Create}
+
+		var batch []fsnotify.Event
+		Eventually(batches).Should(Receive(&batch))
+		Expect(batch).To(HaveLen(2))
+		Expect(batch[0].Name).To(Equal("a.yaml"))
+		Expect(batch[1].Name).To(Equal("b.yaml"))
+
+		Consistently(batches, 300*time.Millisecond).ShouldNot(Receive())
+
+		cancel()
+		Eventually(batches).Should(BeClosed())
+	})
+
+	It("sends a batch after the max wait, even if events keep coming", func() {
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		events := make(chan fsnotify.Event)
+		batches := BatchEvents(ctx, events, ignore.CompileIgnoreLines(), 200*time.Millisecond, 500*time.Millisecond)
+
+		go func() {
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case events <- fsnotify.Event{Name: "a.yaml", Op: fsnotify.Write}:
+					time.Sleep(50 * time.Millisecond)
+				}
+			}
+		}()
+
+		Eventually(batches, 2*time.Second).Should(Receive())
+	})
+})
@@ -0,0 +1,188 @@
+package auth
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/crypto/bcrypt"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// LocalAccountsKey is the key in the cluster-user-auth Secret that lists
+	// the local accounts, as YAML.
+	LocalAccountsKey = "accounts"
+
+	// DefaultLoginMaxAttempts is how many times in a row a password can be
+	// wrong before the account is locked.
+	DefaultLoginMaxAttempts = 5
+	// DefaultLoginLockoutDuration is how long an account is locked for.
+	DefaultLoginLockoutDuration = 15 * time.Minute
+)
+
+// LocalAccount is a user that signs in with a password, rather than through
+// an OIDC provider. The server impersonates the user with their username and
+// groups.
+type LocalAccount struct {
+	Username string `json:"username"`
+	// Password is a bcrypt hash, as made by `gitops get bcrypt-hash`.
+	Password string   `json:"password"`
+	Groups   []string `json:"groups,omitempty"`
+}
+
+// localAccountsFromSecret reads the accounts listed in the secret, plus the
+// single account in its username and password keys, if it has them.
+//
+// The accounts are listed under the accounts key, e.g.
+//
+//	accounts: |
+//	  - username: alice
+//	    password: $2a$10$...
+//	    groups: [gitops-operators]
+//	  - username: bob
+//	    password: $2a$10$...
+//	    groups: [gitops-readers]
+func localAccountsFromSecret(secret corev1.Secret) ([]LocalAccount, error) {
+	accounts := []LocalAccount{}
+
+	if data, ok := secret.Data[LocalAccountsKey]; ok {
+		if err := yaml.UnmarshalStrict(data, &accounts); err != nil {
+			return nil, fmt.Errorf("invalid %s in secret %s: %w", LocalAccountsKey, secret.Name, err)
+		}
+	}
+
+	seen := map[string]bool{}
+
+	for _, a := range accounts {
+		if a.Username == "" || a.Password == "" {
+			return nil, fmt.Errorf("invalid %s in secret %s: every account needs a username and password", LocalAccountsKey, secret.Name)
+		}
+
+		if seen[a.Username] {
+			return nil, fmt.Errorf("invalid %s in secret %s: duplicate username %q", LocalAccountsKey, secret.Name, a.Username)
+		}
+
+		seen[a.Username] = true
+	}
+
+	if password, ok := secret.Data["password"]; ok && !seen[string(secret.Data["username"])] {
+		accounts = append(accounts, LocalAccount{
+			Username: string(secret.Data["username"]),
+			Password: string(password),
+		})
+	}
+
+	return accounts, nil
+}
+
+func findLocalAccount(accounts []LocalAccount, username string) *LocalAccount {
+	for i := range accounts {
+		if accounts[i].Username == username {
+			return &accounts[i]
+		}
+	}
+
+	return nil
+}
+
+var (
+	dummyHashOnce sync.Once
+	dummyHash     []byte
+)
+
+// dummyPasswordHash is compared against when a username isn't found, so
+// that signing in as an unknown user costs the same as a wrong password.
+func dummyPasswordHash() []byte {
+	dummyHashOnce.Do(func() {
+		dummyHash, _ = bcrypt.GenerateFromPassword([]byte("not-a-real-password"), bcrypt.DefaultCost)
+	})
+
+	return dummyHash
+}
+
+// LoginLockout configures how repeated failed sign ins lock an account.
+type LoginLockout struct {
+	// MaxAttempts is how many wrong passwords in a row lock the account,
+	// zero disables the lockout.
+	MaxAttempts int
+	// Duration is how long the account stays locked, and how long failed
+	// attempts are remembered for.
+	Duration time.Duration
+}
+
+type loginAttempts struct {
+	failures    int
+	lastFailure time.Time
+	lockedUntil time.Time
+}
+
+// loginLimiter counts failed sign ins per username. The counts are kept in
+// memory, so each replica of the server counts separately.
+type loginLimiter struct {
+	mu       sync.Mutex
+	attempts map[string]*loginAttempts
+}
+
+func newLoginLimiter() *loginLimiter {
+	return &loginLimiter{attempts: map[string]*loginAttempts{}}
+}
+
+// locked returns whether the account is locked, and until when.
+func (l *loginLimiter) locked(username string) (bool, time.Time) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	a, ok := l.attempts[username]
+	if !ok || time.Now().After(a.lockedUntil) {
+		return false, time.Time{}
+	}
+
+	return true, a.lockedUntil
+}
+
+// failed records a failed sign in, and returns whether that locked the
+// account.
+func (l *loginLimiter) failed(cfg LoginLockout, username string) bool {
+	if cfg.MaxAttempts <= 0 {
+		return false
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+
+	// Forget old failures so the map doesn't grow without bound.
+	for name, a := range l.attempts {
+		if now.Sub(a.lastFailure) > cfg.Duration && now.After(a.lockedUntil) {
+			delete(l.attempts, name)
+		}
+	}
+
+	a, ok := l.attempts[username]
+	if !ok {
+		a = &loginAttempts{}
+		l.attempts[username] = a
+	}
+
+	a.failures++
+	a.lastFailure = now
+
+	if a.failures < cfg.MaxAttempts {
+		return false
+	}
+
+	a.failures = 0
+	a.lockedUntil = now.Add(cfg.Duration)
+
+	return true
+}
+
+func (l *loginLimiter) succeeded(username string) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	delete(l.attempts, username)
+}
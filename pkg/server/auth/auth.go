@@ -13,6 +13,7 @@ import (
 	"github.com/sethvargo/go-limiter/httplimit"
 	"github.com/sethvargo/go-limiter/memorystore"
 	"github.com/weaveworks/weave-gitops/core/logger"
+	"github.com/weaveworks/weave-gitops/core/tracing"
 	"github.com/weaveworks/weave-gitops/pkg/featureflags"
 )
 
@@ -178,10 +179,16 @@ func WithAPIAuth(next http.Handler, srv *AuthServer, publicRoutes []string) http
 				// OIDC tokens may be passed by token or cookie
 				multi.Getters = append(multi.Getters, NewJWTAuthorizationHeaderPrincipalGetter(srv.Log, srv.verifier(), srv.OIDCConfig.ClaimsConfig))
 
-				if srv.oidcPassthroughEnabled() {
+				switch {
+				case srv.oidcPassthroughEnabled() && srv.SessionStore != nil:
+					srv.Log.V(logger.LogLevelDebug).Info("JWT Token Passthrough Enabled")
+					multi.Getters = append(multi.Getters, NewJWTPassthroughSessionCookiePrincipalGetter(srv.Log, srv.verifier(), srv.SessionStore))
+				case srv.oidcPassthroughEnabled():
 					srv.Log.V(logger.LogLevelDebug).Info("JWT Token Passthrough Enabled")
 					multi.Getters = append(multi.Getters, NewJWTPassthroughCookiePrincipalGetter(srv.Log, srv.verifier(), IDTokenCookieName))
-				} else {
+				case srv.SessionStore != nil:
+					multi.Getters = append(multi.Getters, NewJWTSessionCookiePrincipalGetter(srv.Log, srv.verifier(), srv.SessionStore, srv.OIDCConfig.ClaimsConfig))
+				default:
 					multi.Getters = append(multi.Getters, NewJWTCookiePrincipalGetter(srv.Log, srv.verifier(), IDTokenCookieName, srv.OIDCConfig.ClaimsConfig))
 				}
 			}
@@ -189,6 +196,10 @@ func WithAPIAuth(next http.Handler, srv *AuthServer, publicRoutes []string) http
 		case UserAccount:
 			if featureflags.Get(FeatureFlagClusterUser) == FeatureFlagSet {
 				adminAuth := NewJWTAdminCookiePrincipalGetter(srv.Log, srv.tokenSignerVerifier, IDTokenCookieName)
+				if srv.SessionStore != nil {
+					adminAuth = NewJWTAdminSessionCookiePrincipalGetter(srv.Log, srv.tokenSignerVerifier, srv.SessionStore)
+				}
+
 				multi.Getters = append(multi.Getters, adminAuth)
 			}
 
@@ -204,11 +215,18 @@ func WithAPIAuth(next http.Handler, srv *AuthServer, publicRoutes []string) http
 			return
 		}
 
-		principal, err := multi.Principal(r)
+		// The span only covers authentication, so it's not the parent of
+		// the spans for the call itself.
+		authCtx, span := tracing.Tracer().Start(r.Context(), "auth.Authenticate")
+		authReq := r.WithContext(authCtx)
+
+		principal, err := multi.Principal(authReq)
 		if err != nil || principal == nil {
 			var refreshErr error
-			principal, refreshErr = srv.Refresh(rw, r)
+			principal, refreshErr = srv.Refresh(rw, authReq)
 			if refreshErr != nil || principal == nil {
+				span.End()
+
 				srv.Log.V(logger.LogLevelWarn).Info("refreshing token failed", "err", refreshErr, "principal", principal)
 				srv.Log.V(logger.LogLevelWarn).Info("Authentication failed", "err", err, "principal", principal)
 
@@ -219,6 +237,8 @@ func WithAPIAuth(next http.Handler, srv *AuthServer, publicRoutes []string) http
 			srv.Log.Info("Successfully refreshed token", "principal", principal)
 		}
 
+		span.End()
+
 		next.ServeHTTP(rw, r.Clone(WithPrincipal(r.Context(), principal)))
 	})
 }
@@ -2,19 +2,51 @@ package auth
 
 import (
 	"fmt"
+	"path"
+	"strings"
 )
 
 // ClaimsConfig provides the keys to extract the details for a Principal
-// from set of JWT claims.
+// from a JWT token, and how to transform the groups found there.
+//
+// Username and Groups are claim names, or dot separated paths to claims
+// nested in objects, e.g. realm_access.roles. A claim whose name contains
+// dots is preferred over a nested claim with the same path.
 type ClaimsConfig struct {
 	Username string
 	Groups   string
+	// GroupsPrefix is prepended to every group, e.g. "oidc:", so that
+	// groups from the provider can't clash with groups in the cluster.
+	GroupsPrefix string
+	// AllowedGroups, when set, are the only groups passed on for
+	// impersonation. They are shell patterns matched against the group
+	// names as the provider sends them, before any prefix is added.
+	AllowedGroups []string
+	// DeniedGroups are never passed on for impersonation, even if they're
+	// allowed. They're patterns like AllowedGroups.
+	DeniedGroups []string
 }
 
 type claimsToken interface {
 	Claims(v interface{}) error
 }
 
+// Validate checks that the group patterns are well formed, so that a typo
+// can't silently let through a group that was meant to be denied.
+func (c *ClaimsConfig) Validate() error {
+	if c == nil {
+		return nil
+	}
+
+	for _, pattern := range append(append([]string{}, c.AllowedGroups...), c.DeniedGroups...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("invalid group pattern %q: %w", pattern, err)
+		}
+	}
+
+	return nil
+}
+
 // PrincipalFromClaims takes a token and parses the claims using the
 // configuration and returns a configured UserPrincipal with the details in the
 // claims.
@@ -37,27 +69,96 @@ func (c *ClaimsConfig) PrincipalFromClaims(token claimsToken) (*UserPrincipal, e
 		groupsKey = c.Groups
 	}
 
-	id, ok := claims[idKey].(string)
+	id, ok := lookupClaim(claims, idKey).(string)
 	if !ok {
 		return nil, fmt.Errorf("missing %q claim in response", idKey)
 	}
 
-	groups := []string{}
+	groups, err := groupsFromClaim(groupsKey, lookupClaim(claims, groupsKey))
+	if err != nil {
+		return nil, err
+	}
 
-	if v, ok := claims[groupsKey]; ok {
-		gv, ok := v.([]interface{})
+	return &UserPrincipal{ID: id, Groups: c.transformGroups(groups)}, nil
+}
+
+// lookupClaim returns the claim with the given name, or if there isn't one,
+// follows the name as a dot separated path through nested claims.
+func lookupClaim(claims map[string]interface{}, key string) interface{} {
+	if v, ok := claims[key]; ok {
+		return v
+	}
+
+	var current interface{} = claims
+
+	for _, part := range strings.Split(key, ".") {
+		m, ok := current.(map[string]interface{})
 		if !ok {
-			return nil, fmt.Errorf("invalid groups claim %q in response %v", groupsKey, v)
+			return nil
 		}
 
+		if current, ok = m[part]; !ok {
+			return nil
+		}
+	}
+
+	return current
+}
+
+// groupsFromClaim accepts either an array of strings, or a single comma
+// separated string, which is how some providers send a user's groups.
+func groupsFromClaim(key string, v interface{}) ([]string, error) {
+	groups := []string{}
+
+	switch gv := v.(type) {
+	case nil:
+	case string:
+		groups = splitAndTrim(gv)
+	case []interface{}:
 		for _, v := range gv {
-			if s, ok := v.(string); !ok {
-				return nil, fmt.Errorf("invalid groups claim %q in response %v", groupsKey, v)
-			} else {
-				groups = append(groups, s)
+			s, ok := v.(string)
+			if !ok {
+				return nil, fmt.Errorf("invalid groups claim %q in response %v", key, v)
 			}
+
+			groups = append(groups, s)
+		}
+	default:
+		return nil, fmt.Errorf("invalid groups claim %q in response %v", key, v)
+	}
+
+	return groups, nil
+}
+
+func (c *ClaimsConfig) transformGroups(groups []string) []string {
+	if c == nil {
+		return groups
+	}
+
+	result := []string{}
+
+	for _, g := range groups {
+		if len(c.AllowedGroups) > 0 && !matchesAny(c.AllowedGroups, g) {
+			continue
+		}
+
+		if matchesAny(c.DeniedGroups, g) {
+			continue
+		}
+
+		result = append(result, c.GroupsPrefix+g)
+	}
+
+	return result
+}
+
+func matchesAny(patterns []string, group string) bool {
+	for _, pattern := range patterns {
+		// The patterns have been checked by Validate.
+		if ok, _ := path.Match(pattern, group); ok {
+			return true
 		}
 	}
 
-	return &UserPrincipal{ID: id, Groups: groups}, nil
+	return false
 }
@@ -42,6 +42,42 @@ func TestPrincipalFromClaims(t *testing.T) {
 			config: &auth.ClaimsConfig{Groups: "test_groups"},
 			want:   &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"new-group1", "new-group2"}},
 		},
+		{
+			name: "nested groups claim",
+			token: testutils.MakeJWToken(t, privKey, "example@example.com", func(m map[string]any) {
+				m["realm_access"] = map[string]any{"roles": []string{"admin", "viewer"}}
+			}),
+			config: &auth.ClaimsConfig{Groups: "realm_access.roles"},
+			want:   &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"admin", "viewer"}},
+		},
+		{
+			name: "claim name with dots",
+			token: testutils.MakeJWToken(t, privKey, "example@example.com", func(m map[string]any) {
+				m["https://example.com/groups"] = []string{"team-a"}
+			}),
+			config: &auth.ClaimsConfig{Groups: "https://example.com/groups"},
+			want:   &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"team-a"}},
+		},
+		{
+			name: "comma separated groups claim",
+			token: testutils.MakeJWToken(t, privKey, "example@example.com", func(m map[string]any) {
+				m["groups"] = "team-a, team-b,"
+			}),
+			config: &auth.ClaimsConfig{},
+			want:   &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"team-a", "team-b"}},
+		},
+		{
+			name: "prefixed, allowed and denied groups",
+			token: testutils.MakeJWToken(t, privKey, "example@example.com", func(m map[string]any) {
+				m["groups"] = []string{"team-a", "team-b", "system:masters", "other"}
+			}),
+			config: &auth.ClaimsConfig{
+				GroupsPrefix:  "oidc:",
+				AllowedGroups: []string{"team-*", "system:*"},
+				DeniedGroups:  []string{"system:*", "team-b"},
+			},
+			want: &auth.UserPrincipal{ID: "example@example.com", Groups: []string{"oidc:team-a"}},
+		},
 	}
 
 	srv := testutils.MakeKeysetServer(t, privKey)
@@ -65,3 +101,13 @@ func TestPrincipalFromClaims(t *testing.T) {
 		})
 	}
 }
+
+func TestClaimsConfigValidate(t *testing.T) {
+	if err := (&auth.ClaimsConfig{AllowedGroups: []string{"team-*"}, DeniedGroups: []string{"system:*"}}).Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := (&auth.ClaimsConfig{DeniedGroups: []string{"system:[*"}}).Validate(); err == nil {
+		t.Fatal("expected an invalid pattern to be rejected")
+	}
+}
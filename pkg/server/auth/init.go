@@ -3,6 +3,7 @@ package auth
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/weaveworks/weave-gitops/core/logger"
@@ -11,9 +12,20 @@ import (
 	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+// SigningKeyConfig configures where the keys that sign the tokens for
+// local accounts are kept.
+type SigningKeyConfig struct {
+	// SecretName is the Secret in the server's namespace holding the keys.
+	// If it's empty a random key is generated every time the server
+	// starts, which signs out every user on restart.
+	SecretName string
+	// Algorithm is used to generate a key if the Secret doesn't exist.
+	Algorithm string
+}
+
 // InitAuthServer creates a new AuthServer and configures it for the correct
 // authentication methods.
-func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ctrlclient.Client, oidcConfig OIDCConfig, oidcSecret string, namespace string, authMethodStrings []string) (*AuthServer, error) {
+func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ctrlclient.Client, oidcConfig OIDCConfig, oidcSecret string, namespace string, authMethodStrings []string, signingKeys SigningKeyConfig) (*AuthServer, error) {
 	log.V(logger.LogLevelDebug).Info("Registering authentication methods", "methods", authMethodStrings)
 
 	authMethods, err := ParseAuthMethodArray(authMethodStrings)
@@ -54,9 +66,9 @@ func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ct
 		oidcConfig = OIDCConfig{TokenDuration: defaultCookieDuration}
 	}
 
-	tsv, err := NewHMACTokenSignerVerifier(oidcConfig.TokenDuration)
+	tsv, err := newTokenSignerVerifier(ctx, rawKubernetesClient, namespace, signingKeys, oidcConfig.TokenDuration)
 	if err != nil {
-		return nil, fmt.Errorf("could not create HMAC token signer: %w", err)
+		return nil, err
 	}
 
 	if featureflags.Get("WEAVE_GITOPS_FEATURE_DEV_MODE") == "true" {
@@ -76,3 +88,26 @@ func InitAuthServer(ctx context.Context, log logr.Logger, rawKubernetesClient ct
 
 	return authServer, err
 }
+
+type devModeTokenSignerVerifier interface {
+	TokenSignerVerifier
+	SetDevMode(enabled bool)
+}
+
+func newTokenSignerVerifier(ctx context.Context, client ctrlclient.Client, namespace string, cfg SigningKeyConfig, expireAfter time.Duration) (devModeTokenSignerVerifier, error) {
+	if cfg.SecretName == "" {
+		tsv, err := NewHMACTokenSignerVerifier(expireAfter)
+		if err != nil {
+			return nil, fmt.Errorf("could not create HMAC token signer: %w", err)
+		}
+
+		return tsv, nil
+	}
+
+	tsv, err := NewSecretTokenSignerVerifier(ctx, client, namespace, cfg.SecretName, cfg.Algorithm, expireAfter)
+	if err != nil {
+		return nil, fmt.Errorf("could not create token signer: %w", err)
+	}
+
+	return tsv, nil
+}
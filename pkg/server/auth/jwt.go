@@ -32,6 +32,7 @@ type JWTCookiePrincipalGetter struct {
 	verifier     tokenVerifier
 	cookieName   string
 	claimsConfig *ClaimsConfig
+	sessions     SessionStore
 }
 
 // NewJWTCookiePrincipalGetter looks for a cookie in the provided name and
@@ -45,15 +46,53 @@ func NewJWTCookiePrincipalGetter(log logr.Logger, verifier tokenVerifier, cookie
 	}
 }
 
+// NewJWTSessionCookiePrincipalGetter looks for a session ID cookie, and
+// treats the ID token stored in the session as a JWT token that can be
+// decoded to a Principal.
+func NewJWTSessionCookiePrincipalGetter(log logr.Logger, verifier tokenVerifier, sessions SessionStore, config *ClaimsConfig) PrincipalGetter {
+	return &JWTCookiePrincipalGetter{
+		log:          log,
+		verifier:     verifier,
+		cookieName:   SessionCookieName,
+		claimsConfig: config,
+		sessions:     sessions,
+	}
+}
+
 func (pg *JWTCookiePrincipalGetter) Principal(r *http.Request) (*UserPrincipal, error) {
-	cookie, err := r.Cookie(pg.cookieName)
-	if err == http.ErrNoCookie {
-		return nil, nil
+	token, err := idTokenFromCookie(r, pg.cookieName, pg.sessions)
+	if err != nil || token == "" {
+		return nil, err
 	}
 
 	pg.log.V(logger.LogLevelDebug).Info("parsing cookie JWT token", "claimsConfig", pg.claimsConfig)
 
-	return parseJWTToken(r.Context(), pg.verifier, cookie.Value, pg.claimsConfig)
+	return parseJWTToken(r.Context(), pg.verifier, token, pg.claimsConfig)
+}
+
+// idTokenFromCookie returns the ID token in the named cookie, or if there's a
+// session store, the ID token in the session the cookie names. It returns an
+// empty token if there's no cookie or no session.
+func idTokenFromCookie(r *http.Request, cookieName string, sessions SessionStore) (string, error) {
+	cookie, err := r.Cookie(cookieName)
+	if err == http.ErrNoCookie {
+		return "", nil
+	}
+
+	if sessions == nil {
+		return cookie.Value, nil
+	}
+
+	session, err := sessions.Get(r.Context(), cookie.Value)
+	if errors.Is(err, ErrSessionNotFound) {
+		return "", nil
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	return session.IDToken, nil
 }
 
 // JWTAuthorizationHeaderPrincipalGetter inspects the Authorization
@@ -112,6 +151,7 @@ type JWTAdminCookiePrincipalGetter struct {
 	log        logr.Logger
 	verifier   TokenSignerVerifier
 	cookieName string
+	sessions   SessionStore
 }
 
 func NewJWTAdminCookiePrincipalGetter(log logr.Logger, verifier TokenSignerVerifier, cookieName string) PrincipalGetter {
@@ -122,13 +162,24 @@ func NewJWTAdminCookiePrincipalGetter(log logr.Logger, verifier TokenSignerVerif
 	}
 }
 
+// NewJWTAdminSessionCookiePrincipalGetter looks for a session ID cookie, and
+// verifies the ID token stored in the session as one the server signed.
+func NewJWTAdminSessionCookiePrincipalGetter(log logr.Logger, verifier TokenSignerVerifier, sessions SessionStore) PrincipalGetter {
+	return &JWTAdminCookiePrincipalGetter{
+		log:        log,
+		verifier:   verifier,
+		cookieName: SessionCookieName,
+		sessions:   sessions,
+	}
+}
+
 func (pg *JWTAdminCookiePrincipalGetter) Principal(r *http.Request) (*UserPrincipal, error) {
-	cookie, err := r.Cookie(pg.cookieName)
-	if err == http.ErrNoCookie {
-		return nil, nil
+	token, err := idTokenFromCookie(r, pg.cookieName, pg.sessions)
+	if err != nil || token == "" {
+		return nil, err
 	}
 
-	return parseJWTAdminToken(pg.verifier, cookie.Value)
+	return parseJWTAdminToken(pg.verifier, token)
 }
 
 func parseJWTAdminToken(verifier TokenSignerVerifier, rawIDToken string) (*UserPrincipal, error) {
@@ -140,7 +191,7 @@ func parseJWTAdminToken(verifier TokenSignerVerifier, rawIDToken string) (*UserP
 		return nil, nil
 	}
 
-	return &UserPrincipal{ID: claims.Subject, Groups: []string{}}, nil
+	return &UserPrincipal{ID: claims.Subject, Groups: claims.principalGroups()}, nil
 }
 
 // MultiAuthPrincipal looks for a principal in an array of principal getters and
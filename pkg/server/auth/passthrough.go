@@ -78,26 +78,39 @@ type JWTPassthroughCookiePrincipalGetter struct {
 	log        logr.Logger
 	verifier   *oidc.IDTokenVerifier
 	cookieName string
+	sessions   SessionStore
+}
+
+// NewJWTPassthroughSessionCookiePrincipalGetter creates a
+// JWTPassthroughCookiePrincipalGetter that passes through the ID token
+// stored in the session named by the session ID cookie.
+func NewJWTPassthroughSessionCookiePrincipalGetter(log logr.Logger, verifier *oidc.IDTokenVerifier, sessions SessionStore) PrincipalGetter {
+	return &JWTPassthroughCookiePrincipalGetter{
+		log:        log,
+		verifier:   verifier,
+		cookieName: SessionCookieName,
+		sessions:   sessions,
+	}
 }
 
 // Principal implements the PrincipalGetter by pasing the cookie, and if it's
 // valid, it stores the token and user details on the principal.
 func (pg *JWTPassthroughCookiePrincipalGetter) Principal(r *http.Request) (*UserPrincipal, error) {
-	cookie, err := r.Cookie(pg.cookieName)
-	if err == http.ErrNoCookie {
-		return nil, nil
+	token, err := idTokenFromCookie(r, pg.cookieName, pg.sessions)
+	if err != nil || token == "" {
+		return nil, err
 	}
 
 	// This passes nil as the ClaimsConfig because technically we don't really
 	// use the cookie, we're just passing it through, but this could change.
 	// In which case the getter would need an auth config.
-	principal, err := parseJWTToken(r.Context(), pg.verifier, cookie.Value, nil)
+	principal, err := parseJWTToken(r.Context(), pg.verifier, token, nil)
 	if err != nil {
 		return nil, fmt.Errorf("failed to parse for passthrough: %w", err)
 	}
 
 	pg.log.V(4).Info("passing through token")
-	principal.SetToken(cookie.Value)
+	principal.SetToken(token)
 
 	return principal, nil
 }
@@ -0,0 +1,348 @@
+package auth
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ActiveSigningKeyName is the key in the signing keys Secret that names
+	// the key new tokens are signed with. Every other key in the Secret is a
+	// signing key named by its key ID, and is used to verify tokens.
+	ActiveSigningKeyName = "activeKey"
+
+	// SigningAlgorithmHS256 signs with a shared HMAC secret.
+	SigningAlgorithmHS256 = "HS256"
+	// SigningAlgorithmRS256 signs with an RSA private key.
+	SigningAlgorithmRS256 = "RS256"
+	// SigningAlgorithmEdDSA signs with an Ed25519 private key.
+	SigningAlgorithmEdDSA = "EdDSA"
+
+	// How long the keys are cached before they're read from the Secret
+	// again, so that rotations are picked up without a restart.
+	signingKeysRefreshInterval = time.Minute
+	signingKeysRefreshTimeout  = 10 * time.Second
+
+	minHMACKeyLength = 32
+)
+
+type signingKey struct {
+	method    jwt.SigningMethod
+	signKey   interface{}
+	verifyKey interface{}
+}
+
+// SecretTokenSignerVerifier signs and verifies tokens with keys stored in a
+// Secret, so that tokens stay valid across restarts and between replicas.
+//
+// The Secret can hold several keys, so that they can be rotated: add a new
+// key, point activeKey at it, and remove the old key once the tokens it
+// signed have expired. Keys are either an HMAC secret of at least 32 bytes,
+// or a PEM encoded RSA or Ed25519 private key.
+type SecretTokenSignerVerifier struct {
+	client          ctrlclient.Client
+	key             ctrlclient.ObjectKey
+	expireAfter     time.Duration
+	refreshInterval time.Duration
+
+	mu         sync.Mutex
+	keys       map[string]signingKey
+	activeKID  string
+	loadedAt   time.Time
+	refreshing bool
+
+	devMode bool
+}
+
+// NewSecretTokenSignerVerifier reads the signing keys from the Secret, and
+// creates the Secret with a new key using the algorithm if it doesn't
+// exist yet.
+func NewSecretTokenSignerVerifier(ctx context.Context, client ctrlclient.Client, namespace, name, algorithm string, expireAfter time.Duration) (*SecretTokenSignerVerifier, error) {
+	sv := &SecretTokenSignerVerifier{
+		client:          client,
+		key:             ctrlclient.ObjectKey{Namespace: namespace, Name: name},
+		expireAfter:     expireAfter,
+		refreshInterval: signingKeysRefreshInterval,
+	}
+
+	err := sv.load(ctx)
+	if apierrors.IsNotFound(err) {
+		if err = sv.createSecret(ctx, algorithm); apierrors.IsAlreadyExists(err) {
+			// Another replica got there first.
+			err = nil
+		}
+
+		if err == nil {
+			err = sv.load(ctx)
+		}
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not load signing keys from secret %s: %w", sv.key, err)
+	}
+
+	return sv, nil
+}
+
+func (sv *SecretTokenSignerVerifier) Sign(subject string, groups []string) (string, error) {
+	sv.refresh()
+
+	sv.mu.Lock()
+	kid, key := sv.activeKID, sv.keys[sv.activeKID]
+	sv.mu.Unlock()
+
+	claims := AdminClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(sv.expireAfter).UTC()),
+			NotBefore: jwt.NewNumericDate(time.Now().UTC()),
+			Subject:   subject,
+		},
+		Groups: groups,
+	}
+
+	token := jwt.NewWithClaims(key.method, claims)
+	token.Header["kid"] = kid
+
+	return token.SignedString(key.signKey)
+}
+
+func (sv *SecretTokenSignerVerifier) Verify(tokenString string) (*AdminClaims, error) {
+	if sv.devMode {
+		parser := jwt.NewParser()
+
+		token, _, err := parser.ParseUnverified(tokenString, &AdminClaims{})
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse unverified token: %w", err)
+		}
+
+		return token.Claims.(*AdminClaims), nil
+	}
+
+	sv.refresh()
+
+	token, err := jwt.ParseWithClaims(tokenString, &AdminClaims{},
+		func(token *jwt.Token) (interface{}, error) {
+			kid, _ := token.Header["kid"].(string)
+
+			sv.mu.Lock()
+			key, ok := sv.keys[kid]
+			sv.mu.Unlock()
+
+			if !ok {
+				return nil, fmt.Errorf("unknown signing key %q", kid)
+			}
+
+			if token.Method.Alg() != key.method.Alg() {
+				return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
+			}
+
+			return key.verifyKey, nil
+		})
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify token: %w", err)
+	}
+
+	if claims, ok := token.Claims.(*AdminClaims); ok && token.Valid {
+		return claims, nil
+	}
+
+	return nil, errors.New("invalid token")
+}
+
+func (sv *SecretTokenSignerVerifier) SetDevMode(enabled bool) {
+	sv.devMode = enabled
+}
+
+// SetRefreshInterval sets how long the keys are cached before they're read
+// from the Secret again.
+func (sv *SecretTokenSignerVerifier) SetRefreshInterval(interval time.Duration) {
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	sv.refreshInterval = interval
+}
+
+// refresh reloads the keys when they're stale. Only one caller reloads them
+// at a time, the others carry on with the keys already loaded. If the
+// Secret can't be read those keys are kept too, so a blip talking to the
+// API server doesn't log everyone out.
+func (sv *SecretTokenSignerVerifier) refresh() {
+	sv.mu.Lock()
+	if sv.refreshing || time.Since(sv.loadedAt) <= sv.refreshInterval {
+		sv.mu.Unlock()
+		return
+	}
+
+	sv.refreshing = true
+	sv.mu.Unlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), signingKeysRefreshTimeout)
+	defer cancel()
+
+	err := sv.load(ctx)
+
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	if err != nil {
+		sv.loadedAt = time.Now()
+	}
+
+	sv.refreshing = false
+}
+
+func (sv *SecretTokenSignerVerifier) load(ctx context.Context) error {
+	secret := &corev1.Secret{}
+	if err := sv.client.Get(ctx, sv.key, secret); err != nil {
+		return err
+	}
+
+	activeKID := string(secret.Data[ActiveSigningKeyName])
+	keys := map[string]signingKey{}
+
+	for kid, data := range secret.Data {
+		if kid == ActiveSigningKeyName {
+			continue
+		}
+
+		key, err := parseSigningKey(data)
+		if err != nil {
+			return fmt.Errorf("invalid signing key %q: %w", kid, err)
+		}
+
+		keys[kid] = key
+	}
+
+	if activeKID == "" {
+		return fmt.Errorf("no %s set", ActiveSigningKeyName)
+	}
+
+	if _, ok := keys[activeKID]; !ok {
+		return fmt.Errorf("active signing key %q not found", activeKID)
+	}
+
+	sv.mu.Lock()
+	defer sv.mu.Unlock()
+
+	sv.keys = keys
+	sv.activeKID = activeKID
+	sv.loadedAt = time.Now()
+
+	return nil
+}
+
+func (sv *SecretTokenSignerVerifier) createSecret(ctx context.Context, algorithm string) error {
+	data, err := generateSigningKey(algorithm)
+	if err != nil {
+		return err
+	}
+
+	kid := time.Now().UTC().Format("20060102150405")
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sv.key.Name,
+			Namespace: sv.key.Namespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			ActiveSigningKeyName: []byte(kid),
+			kid:                  data,
+		},
+	}
+
+	return sv.client.Create(ctx, secret)
+}
+
+// parseSigningKey reads a PEM encoded RSA or Ed25519 private key, treating
+// anything that isn't PEM as an HMAC secret.
+func parseSigningKey(data []byte) (signingKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		if len(data) < minHMACKeyLength {
+			return signingKey{}, fmt.Errorf("HMAC keys must be at least %d bytes", minHMACKeyLength)
+		}
+
+		return signingKey{method: jwt.SigningMethodHS256, signKey: data, verifyKey: data}, nil
+	}
+
+	var (
+		key interface{}
+		err error
+	)
+
+	if block.Type == "RSA PRIVATE KEY" {
+		key, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	} else {
+		key, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	}
+
+	if err != nil {
+		return signingKey{}, err
+	}
+
+	switch k := key.(type) {
+	case *rsa.PrivateKey:
+		return signingKey{method: jwt.SigningMethodRS256, signKey: k, verifyKey: k.Public()}, nil
+	case ed25519.PrivateKey:
+		return signingKey{method: jwt.SigningMethodEdDSA, signKey: k, verifyKey: k.Public()}, nil
+	}
+
+	return signingKey{}, fmt.Errorf("unsupported private key type %T", key)
+}
+
+func generateSigningKey(algorithm string) ([]byte, error) {
+	var (
+		key crypto.PrivateKey
+		err error
+	)
+
+	switch algorithm {
+	case SigningAlgorithmHS256:
+		hmacSecret := make([]byte, 64)
+		if _, err := rand.Read(hmacSecret); err != nil {
+			return nil, fmt.Errorf("could not generate random HMAC secret: %w", err)
+		}
+
+		return hmacSecret, nil
+	case SigningAlgorithmRS256:
+		key, err = rsa.GenerateKey(rand.Reader, 2048)
+	case SigningAlgorithmEdDSA:
+		_, key, err = ed25519.GenerateKey(rand.Reader)
+	default:
+		return nil, fmt.Errorf("unsupported signing algorithm %q, must be one of: %s", algorithm, strings.Join(SigningAlgorithms(), ", "))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("could not generate %s key: %w", algorithm, err)
+	}
+
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), nil
+}
+
+// SigningAlgorithms lists the algorithms new signing keys can be generated for.
+func SigningAlgorithms() []string {
+	return []string{SigningAlgorithmHS256, SigningAlgorithmRS256, SigningAlgorithmEdDSA}
+}
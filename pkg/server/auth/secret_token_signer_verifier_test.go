@@ -0,0 +1,158 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v4"
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	corev1 "k8s.io/api/core/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlclientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+const signingKeysSecret = "gitops-signing-keys"
+
+func TestSecretTokenSignerVerifierSharesKeys(t *testing.T) {
+	for _, algorithm := range auth.SigningAlgorithms() {
+		t.Run(algorithm, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			ctx := context.Background()
+			client := ctrlclientfake.NewClientBuilder().Build()
+
+			first, err := auth.NewSecretTokenSignerVerifier(ctx, client, testNamespace, signingKeysSecret, algorithm, time.Minute)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			signed, err := first.Sign("admin", nil)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			token, _, err := jwt.NewParser().ParseUnverified(signed, &auth.AdminClaims{})
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(token.Method.Alg()).To(Equal(algorithm))
+			g.Expect(token.Header).To(HaveKey("kid"))
+
+			// A restarted server, or another replica, uses the same keys.
+			second, err := auth.NewSecretTokenSignerVerifier(ctx, client, testNamespace, signingKeysSecret, algorithm, time.Minute)
+			g.Expect(err).NotTo(HaveOccurred())
+
+			claims, err := second.Verify(signed)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(claims.Subject).To(Equal("admin"))
+		})
+	}
+}
+
+func TestSecretTokenSignerVerifierRotation(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	client := ctrlclientfake.NewClientBuilder().Build()
+
+	old, err := auth.NewSecretTokenSignerVerifier(ctx, client, testNamespace, signingKeysSecret, auth.SigningAlgorithmHS256, time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	oldToken, err := old.Sign("admin", nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	secret := &corev1.Secret{}
+	g.Expect(client.Get(ctx, ctrlclient.ObjectKey{Namespace: testNamespace, Name: signingKeysSecret}, secret)).To(Succeed())
+
+	oldKID := string(secret.Data[auth.ActiveSigningKeyName])
+	secret.Data["new"] = []byte("a-brand-new-hmac-secret-that-is-long-enough")
+	secret.Data[auth.ActiveSigningKeyName] = []byte("new")
+	g.Expect(client.Update(ctx, secret)).To(Succeed())
+
+	rotated, err := auth.NewSecretTokenSignerVerifier(ctx, client, testNamespace, signingKeysSecret, auth.SigningAlgorithmHS256, time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	newToken, err := rotated.Sign("admin", nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	token, _, err := jwt.NewParser().ParseUnverified(newToken, &auth.AdminClaims{})
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(token.Header["kid"]).To(Equal("new"))
+
+	_, err = rotated.Verify(oldToken)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	delete(secret.Data, oldKID)
+	g.Expect(client.Update(ctx, secret)).To(Succeed())
+
+	retired, err := auth.NewSecretTokenSignerVerifier(ctx, client, testNamespace, signingKeysSecret, auth.SigningAlgorithmHS256, time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	_, err = retired.Verify(oldToken)
+	g.Expect(err).To(MatchError(ContainSubstring("unknown signing key")))
+
+	_, err = retired.Verify(newToken)
+	g.Expect(err).NotTo(HaveOccurred())
+}
+
+func TestSecretTokenSignerVerifierPicksUpRotationAfterInterval(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+	client := ctrlclientfake.NewClientBuilder().Build()
+
+	sv, err := auth.NewSecretTokenSignerVerifier(ctx, client, testNamespace, signingKeysSecret, auth.SigningAlgorithmHS256, time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	sv.SetRefreshInterval(10 * time.Millisecond)
+
+	oldToken, err := sv.Sign("admin", nil)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	// Another replica rotates the keys and retires the old one.
+	newKey := []byte("a-brand-new-hmac-secret-that-is-long-enough")
+
+	secret := &corev1.Secret{}
+	g.Expect(client.Get(ctx, ctrlclient.ObjectKey{Namespace: testNamespace, Name: signingKeysSecret}, secret)).To(Succeed())
+
+	secret.Data = map[string][]byte{
+		auth.ActiveSigningKeyName: []byte("new"),
+		"new":                     newKey,
+	}
+	g.Expect(client.Update(ctx, secret)).To(Succeed())
+
+	token := jwt.NewWithClaims(jwt.SigningMethodHS256, auth.AdminClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "admin",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+		},
+	})
+	token.Header["kid"] = "new"
+
+	newToken, err := token.SignedString(newKey)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	time.Sleep(20 * time.Millisecond)
+
+	claims, err := sv.Verify(newToken)
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(claims.Subject).To(Equal("admin"))
+
+	_, err = sv.Verify(oldToken)
+	g.Expect(err).To(MatchError(ContainSubstring("unknown signing key")))
+}
+
+func TestSecretTokenSignerVerifierInvalidKeys(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	secret := &corev1.Secret{
+		Data: map[string][]byte{
+			auth.ActiveSigningKeyName: []byte("short"),
+			"short":                   []byte("too-short"),
+		},
+	}
+	secret.Name = signingKeysSecret
+	secret.Namespace = testNamespace
+
+	client := ctrlclientfake.NewClientBuilder().WithObjects(secret).Build()
+
+	_, err := auth.NewSecretTokenSignerVerifier(ctx, client, testNamespace, signingKeysSecret, auth.SigningAlgorithmHS256, time.Minute)
+	g.Expect(err).To(MatchError(ContainSubstring("HMAC keys must be at least")))
+
+	_, err = auth.NewSecretTokenSignerVerifier(ctx, ctrlclientfake.NewClientBuilder().Build(), testNamespace, signingKeysSecret, "HS512", time.Minute)
+	g.Expect(err).To(MatchError(ContainSubstring("unsupported signing algorithm")))
+}
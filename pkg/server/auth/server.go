@@ -13,6 +13,7 @@ import (
 
 	"github.com/coreos/go-oidc/v3/oidc"
 	"github.com/go-logr/logr"
+	"github.com/weaveworks/weave-gitops/core/audit"
 	"github.com/weaveworks/weave-gitops/pkg/featureflags"
 	"golang.org/x/crypto/bcrypt"
 	"golang.org/x/oauth2"
@@ -72,12 +73,21 @@ type AuthConfig struct {
 	OIDCConfig          OIDCConfig
 	authMethods         map[AuthMethod]bool
 	namespace           string
+	// AuditSink records sign ins and outs, it defaults to discarding them.
+	AuditSink audit.AuditSink
+	// LoginLockout locks local accounts after repeated failed sign ins.
+	LoginLockout LoginLockout
+	// SessionStore, when set, keeps the tokens on the server and only gives
+	// the browser a session ID cookie. Otherwise the tokens are kept in
+	// cookies.
+	SessionStore SessionStore
 }
 
 // AuthServer interacts with an OIDC issuer to handle the OAuth2 process flow.
 type AuthServer struct {
 	AuthConfig
-	provider *oidc.Provider
+	provider     *oidc.Provider
+	loginLimiter *loginLimiter
 }
 
 // LoginRequest represents the data submitted by client when the auth flow (non-OIDC) is used.
@@ -105,6 +115,9 @@ type UserInfo struct {
 // - tokenDuration - defaults to 1 hour.
 // - claimUsername - defaults to "email"
 // - claimGroups - defaults to "groups"
+// - groupsPrefix - prepended to every group
+// - allowedGroups - comma separated patterns, only these groups are used for impersonation
+// - deniedGroups - comma separated patterns, these groups are never used for impersonation
 func NewOIDCConfigFromSecret(secret corev1.Secret) OIDCConfig {
 	cfg := OIDCConfig{
 		IssuerURL:    string(secret.Data["issuerURL"]),
@@ -156,10 +169,21 @@ func claimsConfigFromSecret(secret corev1.Secret) *ClaimsConfig {
 	}
 
 	if len(claimUsername) > 0 && len(claimGroups) > 0 {
-		return &ClaimsConfig{
-			Username: string(claimUsername),
-			Groups:   string(claimGroups),
+		cfg := &ClaimsConfig{
+			Username:     string(claimUsername),
+			Groups:       string(claimGroups),
+			GroupsPrefix: string(secret.Data["groupsPrefix"]),
 		}
+
+		if allowed := splitAndTrim(string(secret.Data["allowedGroups"])); len(allowed) > 0 {
+			cfg.AllowedGroups = allowed
+		}
+
+		if denied := splitAndTrim(string(secret.Data["deniedGroups"])); len(denied) > 0 {
+			cfg.DeniedGroups = denied
+		}
+
+		return cfg
 	}
 
 	return nil
@@ -174,6 +198,10 @@ func NewAuthServerConfig(log logr.Logger, oidcCfg OIDCConfig, kubernetesClient c
 		if _, err := url.Parse(oidcCfg.RedirectURL); err != nil {
 			return AuthConfig{}, fmt.Errorf("invalid redirect URL: %w", err)
 		}
+
+		if err := oidcCfg.ClaimsConfig.Validate(); err != nil {
+			return AuthConfig{}, fmt.Errorf("invalid claims configuration: %w", err)
+		}
 	}
 
 	return AuthConfig{
@@ -184,6 +212,10 @@ func NewAuthServerConfig(log logr.Logger, oidcCfg OIDCConfig, kubernetesClient c
 		OIDCConfig:          oidcCfg,
 		namespace:           namespace,
 		authMethods:         authMethods,
+		LoginLockout: LoginLockout{
+			MaxAttempts: DefaultLoginMaxAttempts,
+			Duration:    DefaultLoginLockoutDuration,
+		},
 	}, nil
 }
 
@@ -223,7 +255,11 @@ func NewAuthServer(ctx context.Context, cfg AuthConfig) (*AuthServer, error) {
 		return nil, fmt.Errorf("neither OIDC auth or local auth enabled, can't start")
 	}
 
-	return &AuthServer{cfg, provider}, nil
+	if cfg.AuditSink == nil {
+		cfg.AuditSink = audit.NoopSink{}
+	}
+
+	return &AuthServer{cfg, provider, newLoginLimiter()}, nil
 }
 
 // SetRedirectURL is used to set the redirect URL. This is meant to be used
@@ -292,6 +328,7 @@ func (s *AuthServer) Callback() http.HandlerFunc {
 		// Authorization redirect callback from OAuth2 auth flow.
 		if errorCode := r.FormValue("error"); errorCode != "" {
 			s.Log.Info("authz redirect callback failed", "error", errorCode, "error_description", r.FormValue("error_description"))
+			s.recordAudit(r.Context(), audit.ActionCallback, nil, fmt.Errorf("authz redirect callback failed: %s", errorCode))
 			rw.WriteHeader(http.StatusBadRequest)
 
 			return
@@ -308,6 +345,7 @@ func (s *AuthServer) Callback() http.HandlerFunc {
 		cookie, err := r.Cookie(StateCookieName)
 		if err != nil {
 			s.Log.Error(err, "cookie was not found in the request", "cookie", StateCookieName)
+			s.recordAudit(r.Context(), audit.ActionCallback, nil, errors.New("state cookie was not found in the request"))
 			rw.WriteHeader(http.StatusBadRequest)
 
 			return
@@ -315,6 +353,7 @@ func (s *AuthServer) Callback() http.HandlerFunc {
 
 		if state := r.FormValue("state"); state != cookie.Value {
 			s.Log.Info("cookie value does not match state form value")
+			s.recordAudit(r.Context(), audit.ActionCallback, nil, errors.New("state cookie does not match the state form value"))
 			rw.WriteHeader(http.StatusBadRequest)
 
 			return
@@ -323,6 +362,7 @@ func (s *AuthServer) Callback() http.HandlerFunc {
 		b, err := base64.StdEncoding.DecodeString(cookie.Value)
 		if err != nil {
 			s.Log.Error(err, "cannot base64 decode cookie", "cookie", StateCookieName, "cookie_value", cookie.Value)
+			s.recordAudit(r.Context(), audit.ActionCallback, nil, fmt.Errorf("cannot base64 decode state cookie: %w", err))
 			rw.WriteHeader(http.StatusBadRequest)
 
 			return
@@ -330,6 +370,7 @@ func (s *AuthServer) Callback() http.HandlerFunc {
 
 		if err := json.Unmarshal(b, &state); err != nil {
 			s.Log.Error(err, "failed to unmarshal state to JSON", "state", string(b))
+			s.recordAudit(r.Context(), audit.ActionCallback, nil, fmt.Errorf("failed to unmarshal state to JSON: %w", err))
 			rw.WriteHeader(http.StatusBadRequest)
 
 			return
@@ -338,6 +379,7 @@ func (s *AuthServer) Callback() http.HandlerFunc {
 		token, err = s.oauth2Config(nil).Exchange(ctx, code)
 		if err != nil {
 			s.Log.Error(err, "failed to exchange auth code for token", "code", code)
+			s.recordAudit(r.Context(), audit.ActionCallback, nil, fmt.Errorf("failed to exchange auth code for token: %w", err))
 			rw.WriteHeader(http.StatusInternalServerError)
 
 			return
@@ -345,20 +387,31 @@ func (s *AuthServer) Callback() http.HandlerFunc {
 
 		rawIDToken, ok := token.Extra("id_token").(string)
 		if !ok {
+			s.recordAudit(r.Context(), audit.ActionCallback, nil, errors.New("no id_token in token response"))
 			JSONError(s.Log, rw, "no id_token in token response", http.StatusInternalServerError)
+
 			return
 		}
 
-		_, err = s.verifier().Verify(r.Context(), rawIDToken)
+		principal, err := parseJWTToken(r.Context(), s.verifier(), rawIDToken, s.OIDCConfig.ClaimsConfig)
 		if err != nil {
+			s.recordAudit(r.Context(), audit.ActionCallback, nil, err)
 			JSONError(s.Log, rw, fmt.Sprintf("failed to verify ID token: %v", err), http.StatusInternalServerError)
+
 			return
 		}
 
-		// Issue ID token cookie
-		http.SetCookie(rw, s.createCookie(IDTokenCookieName, rawIDToken))
-		http.SetCookie(rw, s.createCookie(AccessTokenCookieName, token.AccessToken))
-		http.SetCookie(rw, s.createCookie(RefreshTokenCookieName, token.RefreshToken))
+		s.recordAudit(r.Context(), audit.ActionCallback, principal, nil)
+
+		if err := s.startSession(r.Context(), rw, &Session{
+			IDToken:      rawIDToken,
+			AccessToken:  token.AccessToken,
+			RefreshToken: token.RefreshToken,
+		}); err != nil {
+			JSONError(s.Log, rw, fmt.Sprintf("failed to start session: %v", err), http.StatusInternalServerError)
+
+			return
+		}
 
 		// Clear state cookie
 		http.SetCookie(rw, s.clearCookie(StateCookieName))
@@ -398,21 +451,52 @@ func (s *AuthServer) SignIn() http.HandlerFunc {
 			return
 		}
 
-		if loginRequest.Username != string(hashedSecret.Data["username"]) {
+		accounts, err := localAccountsFromSecret(hashedSecret)
+		if err != nil {
+			s.Log.Error(err, "Failed to read the local accounts")
+			JSONError(s.Log, rw, "The local accounts are misconfigured.", http.StatusInternalServerError)
+
+			return
+		}
+
+		principal := &UserPrincipal{ID: loginRequest.Username}
+
+		if locked, until := s.loginLimiter.locked(loginRequest.Username); locked {
+			s.Log.Info("Account locked", "username", loginRequest.Username, "until", until)
+			s.recordAudit(r.Context(), audit.ActionSignIn, principal, errors.New("account locked"))
+			JSONError(s.Log, rw, fmt.Sprintf("Too many failed sign ins, try again after %s.", until.UTC().Format(time.RFC3339)), http.StatusTooManyRequests)
+
+			return
+		}
+
+		account := findLocalAccount(accounts, loginRequest.Username)
+		if account == nil {
+			// Take as long as a wrong password would, so the response time
+			// doesn't give away which usernames exist. Unknown usernames
+			// aren't counted towards a lockout, so they can't fill the
+			// attempts map.
+			_ = bcrypt.CompareHashAndPassword(dummyPasswordHash(), []byte(loginRequest.Password))
+
 			s.Log.Info("Wrong username")
+			s.recordAudit(r.Context(), audit.ActionSignIn, principal, errors.New("wrong username"))
 			rw.WriteHeader(http.StatusUnauthorized)
 
 			return
 		}
 
-		if err := bcrypt.CompareHashAndPassword(hashedSecret.Data["password"], []byte(loginRequest.Password)); err != nil {
+		if err := bcrypt.CompareHashAndPassword([]byte(account.Password), []byte(loginRequest.Password)); err != nil {
 			s.Log.Error(err, "Failed to compare hash with password")
+			s.loginFailed(loginRequest.Username)
+			s.recordAudit(r.Context(), audit.ActionSignIn, principal, errors.New("wrong password"))
 			rw.WriteHeader(http.StatusUnauthorized)
 
 			return
 		}
 
-		signed, err := s.tokenSignerVerifier.Sign(loginRequest.Username)
+		s.loginLimiter.succeeded(loginRequest.Username)
+		principal.Groups = account.Groups
+
+		signed, err := s.tokenSignerVerifier.Sign(account.Username, account.Groups)
 		if err != nil {
 			s.Log.Error(err, "Failed to create and sign token")
 			rw.WriteHeader(http.StatusInternalServerError)
@@ -420,7 +504,15 @@ func (s *AuthServer) SignIn() http.HandlerFunc {
 			return
 		}
 
-		http.SetCookie(rw, s.createCookie(IDTokenCookieName, signed))
+		if err := s.startSession(r.Context(), rw, &Session{IDToken: signed}); err != nil {
+			s.Log.Error(err, "Failed to start session")
+			rw.WriteHeader(http.StatusInternalServerError)
+
+			return
+		}
+
+		s.recordAudit(r.Context(), audit.ActionSignIn, principal, nil)
+
 		rw.WriteHeader(http.StatusOK)
 	}
 }
@@ -437,7 +529,7 @@ func (s *AuthServer) UserInfo(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	c, err := findAuthCookie(r)
+	authToken, err := s.findAuthToken(r)
 	if err != nil {
 		s.Log.Error(err, "Failed to get cookie from request")
 		rw.WriteHeader(http.StatusBadRequest)
@@ -445,11 +537,12 @@ func (s *AuthServer) UserInfo(rw http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	claims, err := s.tokenSignerVerifier.Verify(c.Value)
+	claims, err := s.tokenSignerVerifier.Verify(authToken)
 	if err == nil {
 		ui := UserInfo{
-			ID:    claims.Subject,
-			Email: claims.Subject,
+			ID:     claims.Subject,
+			Email:  claims.Subject,
+			Groups: claims.principalGroups(),
 		}
 		toJSON(rw, ui, s.Log)
 
@@ -464,7 +557,7 @@ func (s *AuthServer) UserInfo(rw http.ResponseWriter, r *http.Request) {
 	}
 
 	info, err := s.provider.UserInfo(r.Context(), oauth2.StaticTokenSource(&oauth2.Token{
-		AccessToken: c.Value,
+		AccessToken: authToken,
 	}))
 	if err != nil {
 		s.Log.Error(err, "failed to query userinfo")
@@ -495,15 +588,15 @@ func (s *AuthServer) UserInfo(rw http.ResponseWriter, r *http.Request) {
 func (s *AuthServer) Refresh(rw http.ResponseWriter, r *http.Request) (*UserPrincipal, error) {
 	ctx := oidc.ClientContext(r.Context(), s.client)
 
-	refreshTokenCookie, err := r.Cookie(RefreshTokenCookieName)
-	if err != nil {
+	sessionID, session, err := s.session(r)
+	if err != nil || session.RefreshToken == "" {
 		return nil, errors.New("couldn't fetch refresh token from cookie")
 	}
 
 	token, err := s.oauth2Config(nil).TokenSource(
 		ctx,
 		&oauth2.Token{
-			RefreshToken: refreshTokenCookie.Value,
+			RefreshToken: session.RefreshToken,
 		}).Token()
 	if err != nil {
 		return nil, fmt.Errorf("failed to refresh token: %w", err)
@@ -514,9 +607,15 @@ func (s *AuthServer) Refresh(rw http.ResponseWriter, r *http.Request) (*UserPrin
 		return nil, errors.New("no id_token in token response")
 	}
 
-	http.SetCookie(rw, s.createCookie(IDTokenCookieName, rawIDToken))
-	http.SetCookie(rw, s.createCookie(AccessTokenCookieName, token.AccessToken))
-	http.SetCookie(rw, s.createCookie(RefreshTokenCookieName, token.RefreshToken))
+	session = &Session{
+		IDToken:      rawIDToken,
+		AccessToken:  token.AccessToken,
+		RefreshToken: token.RefreshToken,
+	}
+
+	if err := s.saveSession(ctx, rw, sessionID, session); err != nil {
+		return nil, err
+	}
 
 	return parseJWTToken(ctx, s.verifier(), rawIDToken, s.OIDCConfig.ClaimsConfig)
 }
@@ -575,12 +674,179 @@ func (s *AuthServer) Logout() http.HandlerFunc {
 			return
 		}
 
+		s.recordAudit(r.Context(), audit.ActionLogout, s.principalFromCookie(r), nil)
+
+		if s.SessionStore != nil {
+			if cookie, err := r.Cookie(SessionCookieName); err == nil {
+				if err := s.SessionStore.Delete(r.Context(), cookie.Value); err != nil {
+					s.Log.Error(err, "Failed to delete session")
+					rw.WriteHeader(http.StatusInternalServerError)
+
+					return
+				}
+			}
+
+			http.SetCookie(rw, s.clearCookie(SessionCookieName))
+		}
+
 		http.SetCookie(rw, s.clearCookie(IDTokenCookieName))
 		http.SetCookie(rw, s.clearCookie(AccessTokenCookieName))
 		rw.WriteHeader(http.StatusOK)
 	}
 }
 
+// principalFromCookie makes a best effort attempt at working out who is
+// making the request from the ID token cookie, returning nil if it can't.
+func (s *AuthServer) principalFromCookie(r *http.Request) *UserPrincipal {
+	_, session, err := s.session(r)
+	if err != nil || session.IDToken == "" {
+		return nil
+	}
+
+	if claims, err := s.tokenSignerVerifier.Verify(session.IDToken); err == nil {
+		return &UserPrincipal{ID: claims.Subject, Groups: claims.principalGroups()}
+	}
+
+	if s.oidcEnabled() {
+		if principal, err := parseJWTToken(r.Context(), s.verifier(), session.IDToken, s.OIDCConfig.ClaimsConfig); err == nil {
+			return principal
+		}
+	}
+
+	return nil
+}
+
+func (s *AuthServer) loginFailed(username string) {
+	if s.loginLimiter.failed(s.LoginLockout, username) {
+		s.Log.Info("Locking account after repeated failed sign ins", "username", username, "duration", s.LoginLockout.Duration)
+	}
+}
+
+// startSession saves the tokens of a newly signed in user in a new session.
+func (s *AuthServer) startSession(ctx context.Context, rw http.ResponseWriter, session *Session) error {
+	var id string
+
+	if s.SessionStore != nil {
+		var err error
+
+		if id, err = NewSessionID(); err != nil {
+			return fmt.Errorf("generating session ID: %w", err)
+		}
+	}
+
+	return s.saveSession(ctx, rw, id, session)
+}
+
+// saveSession stores the tokens in the session store under the ID, and gives
+// the browser the ID, or if there's no session store, gives the browser the
+// tokens.
+func (s *AuthServer) saveSession(ctx context.Context, rw http.ResponseWriter, id string, session *Session) error {
+	if s.SessionStore == nil {
+		http.SetCookie(rw, s.createCookie(IDTokenCookieName, session.IDToken))
+
+		if session.AccessToken != "" {
+			http.SetCookie(rw, s.createCookie(AccessTokenCookieName, session.AccessToken))
+		}
+
+		if session.RefreshToken != "" {
+			http.SetCookie(rw, s.createCookie(RefreshTokenCookieName, session.RefreshToken))
+		}
+
+		return nil
+	}
+
+	duration := s.OIDCConfig.TokenDuration
+	if duration == 0 {
+		duration = defaultCookieDuration
+	}
+
+	session.Expiry = time.Now().UTC().Add(duration)
+
+	if err := s.SessionStore.Set(ctx, id, session); err != nil {
+		return err
+	}
+
+	http.SetCookie(rw, s.createCookie(SessionCookieName, id))
+
+	return nil
+}
+
+// session returns the tokens for the request, from the session store if
+// there is one, otherwise from the cookies. It returns the session ID too,
+// which is empty when there's no session store.
+func (s *AuthServer) session(r *http.Request) (string, *Session, error) {
+	if s.SessionStore == nil {
+		session := &Session{}
+
+		for name, token := range map[string]*string{
+			IDTokenCookieName:      &session.IDToken,
+			AccessTokenCookieName:  &session.AccessToken,
+			RefreshTokenCookieName: &session.RefreshToken,
+		} {
+			if cookie, err := r.Cookie(name); err == nil {
+				*token = cookie.Value
+			}
+		}
+
+		return "", session, nil
+	}
+
+	cookie, err := r.Cookie(SessionCookieName)
+	if err != nil {
+		return "", nil, err
+	}
+
+	session, err := s.SessionStore.Get(r.Context(), cookie.Value)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return cookie.Value, session, nil
+}
+
+// findAuthToken tries to retrieve the access token obtained through OIDC
+// first and, if that doesn't exist, falls back to the ID token issued by
+// authenticating using the cluster-user-auth Secret. This way, users can
+// use both ways to log into weave-gitops.
+func (s *AuthServer) findAuthToken(r *http.Request) (string, error) {
+	if s.SessionStore == nil {
+		for _, name := range []string{AccessTokenCookieName, IDTokenCookieName} {
+			if c, err := r.Cookie(name); err == nil {
+				return c.Value, nil
+			}
+		}
+
+		return "", http.ErrNoCookie
+	}
+
+	_, session, err := s.session(r)
+	if err != nil {
+		return "", err
+	}
+
+	if session.AccessToken != "" {
+		return session.AccessToken, nil
+	}
+
+	return session.IDToken, nil
+}
+
+func (s *AuthServer) recordAudit(ctx context.Context, action audit.Action, principal *UserPrincipal, err error) {
+	var (
+		id     string
+		groups []string
+	)
+
+	if principal != nil {
+		id = principal.ID
+		groups = principal.Groups
+	}
+
+	if err := s.AuditSink.Record(ctx, audit.NewEvent(action, id, groups, err)); err != nil {
+		s.Log.Error(err, "failed recording audit event", "action", action, "user", id)
+	}
+}
+
 func (s *AuthServer) createCookie(name, value string) *http.Cookie {
 	cookie := &http.Cookie{
 		Name:     name,
@@ -646,18 +912,3 @@ func JSONError(log logr.Logger, w http.ResponseWriter, errStr string, code int)
 		log.Error(err, "failed encoding error message", "message", errStr)
 	}
 }
-
-// try to retrieve the access token obtained through OIDC first and, if that doesn't exist,
-// fall back to the ID token issued by authenticating using the cluster-user-auth Secret. This way,
-// users can use both ways to log into weave-gitops.
-func findAuthCookie(req *http.Request) (*http.Cookie, error) {
-	cookieNames := []string{AccessTokenCookieName, IDTokenCookieName}
-	for _, name := range cookieNames {
-		c, err := req.Cookie(name)
-		if err == nil {
-			return c, nil
-		}
-	}
-
-	return nil, http.ErrNoCookie
-}
@@ -18,6 +18,8 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/oauth2-proxy/mockoidc"
 	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/audit"
+	"github.com/weaveworks/weave-gitops/core/audit/auditfakes"
 	"github.com/weaveworks/weave-gitops/pkg/featureflags"
 	"github.com/weaveworks/weave-gitops/pkg/server/auth"
 	"golang.org/x/crypto/bcrypt"
@@ -112,6 +114,32 @@ func TestCallbackStateCookieNotValid(t *testing.T) {
 	g.Expect(w.Result().StatusCode).To(Equal(http.StatusBadRequest))
 }
 
+func TestCallbackStateCookieNotValidIsAudited(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	s, _ := makeAuthServer(t, nil, nil, []auth.AuthMethod{auth.OIDC})
+
+	sink := &auditfakes.FakeAuditSink{}
+	s.AuditSink = sink
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/callback?code=123&state=some_state", nil)
+	req.AddCookie(&http.Cookie{
+		Name:  auth.StateCookieName,
+		Value: "some_different_state",
+	})
+
+	w := httptest.NewRecorder()
+	s.Callback().ServeHTTP(w, req)
+
+	g.Expect(sink.RecordCallCount()).To(Equal(1))
+
+	_, event := sink.RecordArgsForCall(0)
+	g.Expect(event.Action).To(Equal(audit.ActionCallback))
+	g.Expect(event.Principal).To(BeEmpty())
+	g.Expect(event.Outcome).To(Equal(audit.OutcomeFailure))
+	g.Expect(event.Error).To(ContainSubstring("state"))
+}
+
 func TestCallbackStateCookieNotBase64Encoded(t *testing.T) {
 	g := NewGomegaWithT(t)
 
@@ -438,7 +466,7 @@ func TestUserInfoAdminFlow(t *testing.T) {
 	fakeKubernetesClient := ctrlclientfake.NewClientBuilder().WithObjects(hashedSecret).Build()
 	s, _ := makeAuthServer(t, fakeKubernetesClient, tokenSignerVerifier, []auth.AuthMethod{auth.UserAccount})
 
-	signed, err := tokenSignerVerifier.Sign("wego-admin")
+	signed, err := tokenSignerVerifier.Sign("wego-admin", nil)
 	g.Expect(err).NotTo(HaveOccurred())
 
 	req := httptest.NewRequest(http.MethodGet, "https://example.com/userinfo", nil)
@@ -479,7 +507,7 @@ func TestUserInfoAdminFlow_differentUsername(t *testing.T) {
 
 	s, _ := makeAuthServer(t, fakeKubernetesClient, tokenSignerVerifier, []auth.AuthMethod{auth.UserAccount})
 
-	signed, err := tokenSignerVerifier.Sign("dev")
+	signed, err := tokenSignerVerifier.Sign("dev", nil)
 	g.Expect(err).NotTo(HaveOccurred())
 
 	req := httptest.NewRequest(http.MethodGet, "https://example.com/userinfo", nil)
@@ -1033,6 +1061,26 @@ func TestNewOIDCConfigFromSecret(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "group transforms",
+			data: map[string][]byte{
+				"claimGroups":   []byte("realm_access.roles"),
+				"groupsPrefix":  []byte("oidc:"),
+				"allowedGroups": []byte("team-*, admins"),
+				"deniedGroups":  []byte("system:*"),
+			},
+			want: auth.OIDCConfig{
+				TokenDuration: time.Hour * 1,
+				Scopes:        []string{oidc.ScopeOpenID, oidc.ScopeOfflineAccess, auth.ScopeEmail, auth.ScopeGroups},
+				ClaimsConfig: &auth.ClaimsConfig{
+					Username:      "email",
+					Groups:        "realm_access.roles",
+					GroupsPrefix:  "oidc:",
+					AllowedGroups: []string{"team-*", "admins"},
+					DeniedGroups:  []string{"system:*"},
+				},
+			},
+		},
 	}
 
 	for _, tt := range configTests {
@@ -1054,3 +1102,200 @@ func valuesFromMap(data map[string]string) url.Values {
 
 	return vals
 }
+
+func TestLogoutInvalidatesSession(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte("my-secret-password"), bcrypt.DefaultCost)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	hashedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-user-auth",
+			Namespace: "flux-system",
+		},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": hashed,
+		},
+	}
+	fakeKubernetesClient := ctrlclientfake.NewClientBuilder().WithObjects(hashedSecret).Build()
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, _ := makeAuthServer(t, fakeKubernetesClient, tokenSignerVerifier, []auth.AuthMethod{auth.UserAccount})
+	s.SessionStore = auth.NewMemorySessionStore()
+
+	j, err := json.Marshal(auth.LoginRequest{Username: "admin", Password: "my-secret-password"})
+	g.Expect(err).NotTo(HaveOccurred())
+
+	w := httptest.NewRecorder()
+	s.SignIn().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "https://example.com/signin", bytes.NewReader(j)))
+	g.Expect(w.Code).To(Equal(http.StatusOK))
+
+	cookies := w.Result().Cookies()
+	g.Expect(cookies).To(HaveLen(1))
+	g.Expect(cookies[0].Name).To(Equal(auth.SessionCookieName))
+
+	session := cookies[0]
+	api := auth.WithAPIAuth(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		g.Expect(auth.Principal(r.Context()).ID).To(Equal("admin"))
+	}), s, nil)
+
+	req := httptest.NewRequest(http.MethodGet, "https://example.com/v1/objects", nil)
+	req.AddCookie(session)
+
+	w = httptest.NewRecorder()
+	api.ServeHTTP(w, req)
+	g.Expect(w.Code).To(Equal(http.StatusOK))
+
+	req = httptest.NewRequest(http.MethodPost, "https://example.com/logout", nil)
+	req.AddCookie(session)
+
+	w = httptest.NewRecorder()
+	s.Logout().ServeHTTP(w, req)
+	g.Expect(w.Code).To(Equal(http.StatusOK))
+
+	req = httptest.NewRequest(http.MethodGet, "https://example.com/v1/objects", nil)
+	req.AddCookie(session)
+
+	w = httptest.NewRecorder()
+	api.ServeHTTP(w, req)
+	g.Expect(w.Code).To(Equal(http.StatusUnauthorized))
+}
+
+func TestSignInLocalAccounts(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	operatorHash, err := bcrypt.GenerateFromPassword([]byte("operator-password"), bcrypt.MinCost)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	readerHash, err := bcrypt.GenerateFromPassword([]byte("reader-password"), bcrypt.MinCost)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	adminHash, err := bcrypt.GenerateFromPassword([]byte("admin-password"), bcrypt.MinCost)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	hashedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-user-auth",
+			Namespace: "flux-system",
+		},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": adminHash,
+			"accounts": []byte(fmt.Sprintf(`
+- username: alice
+  password: %s
+  groups: [gitops-operators]
+- username: bob
+  password: %s
+  groups: [gitops-readers, team-b]
+`, operatorHash, readerHash)),
+		},
+	}
+	fakeKubernetesClient := ctrlclientfake.NewClientBuilder().WithObjects(hashedSecret).Build()
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, _ := makeAuthServer(t, fakeKubernetesClient, tokenSignerVerifier, []auth.AuthMethod{auth.UserAccount})
+
+	for username, tc := range map[string]struct {
+		password string
+		groups   []string
+	}{
+		"alice": {password: "operator-password", groups: []string{"gitops-operators"}},
+		"bob":   {password: "reader-password", groups: []string{"gitops-readers", "team-b"}},
+		"admin": {password: "admin-password", groups: []string{}},
+	} {
+		cookie := signIn(t, s, username, tc.password)
+		g.Expect(cookie).NotTo(BeNil(), username)
+
+		var principal *auth.UserPrincipal
+
+		req := httptest.NewRequest(http.MethodGet, "https://example.com/v1/objects", nil)
+		req.AddCookie(cookie)
+
+		auth.WithAPIAuth(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+			principal = auth.Principal(r.Context())
+		}), s, nil).ServeHTTP(httptest.NewRecorder(), req)
+
+		g.Expect(principal).NotTo(BeNil(), username)
+		g.Expect(principal.ID).To(Equal(username))
+		g.Expect(principal.Groups).To(Equal(tc.groups), username)
+	}
+
+	g.Expect(signIn(t, s, "alice", "reader-password")).To(BeNil())
+	g.Expect(signIn(t, s, "carol", "reader-password")).To(BeNil())
+}
+
+func TestSignInLockout(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	hashed, err := bcrypt.GenerateFromPassword([]byte("my-secret-password"), bcrypt.MinCost)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	hashedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "cluster-user-auth",
+			Namespace: "flux-system",
+		},
+		Data: map[string][]byte{
+			"username": []byte("admin"),
+			"password": hashed,
+		},
+	}
+	fakeKubernetesClient := ctrlclientfake.NewClientBuilder().WithObjects(hashedSecret).Build()
+
+	tokenSignerVerifier, err := auth.NewHMACTokenSignerVerifier(5 * time.Minute)
+	g.Expect(err).NotTo(HaveOccurred())
+
+	s, _ := makeAuthServer(t, fakeKubernetesClient, tokenSignerVerifier, []auth.AuthMethod{auth.UserAccount})
+	s.LoginLockout = auth.LoginLockout{MaxAttempts: 3, Duration: time.Minute}
+
+	for i := 0; i < 3; i++ {
+		g.Expect(signInStatus(s, "admin", "wrong")).To(Equal(http.StatusUnauthorized))
+	}
+
+	g.Expect(signInStatus(s, "admin", "my-secret-password")).To(Equal(http.StatusTooManyRequests))
+
+	// Usernames that don't exist are never locked, so they can't be told
+	// apart from real ones.
+	for i := 0; i < 4; i++ {
+		g.Expect(signInStatus(s, "nobody", "wrong")).To(Equal(http.StatusUnauthorized))
+	}
+
+	s.LoginLockout = auth.LoginLockout{}
+	g.Expect(signInStatus(s, "other", "wrong")).To(Equal(http.StatusUnauthorized))
+}
+
+func signInStatus(s *auth.AuthServer, username, password string) int {
+	j, _ := json.Marshal(auth.LoginRequest{Username: username, Password: password})
+
+	w := httptest.NewRecorder()
+	s.SignIn().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "https://example.com/signin", bytes.NewReader(j)))
+
+	return w.Code
+}
+
+func signIn(t *testing.T, s *auth.AuthServer, username, password string) *http.Cookie {
+	t.Helper()
+
+	j, err := json.Marshal(auth.LoginRequest{Username: username, Password: password})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	w := httptest.NewRecorder()
+	s.SignIn().ServeHTTP(w, httptest.NewRequest(http.MethodPost, "https://example.com/signin", bytes.NewReader(j)))
+
+	for _, c := range w.Result().Cookies() {
+		if c.Name == auth.IDTokenCookieName {
+			return c
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,267 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// SessionCookieName is the name of the cookie that holds the opaque
+	// session ID when a SessionStore is configured.
+	SessionCookieName = "session_id"
+
+	// SessionSecretLabel is set on the Secrets the SecretSessionStore creates,
+	// so they can be found to prune them.
+	SessionSecretLabel = "weave.works/gitops-session"
+
+	sessionSecretPrefix = "gitops-session-"
+)
+
+// ErrSessionNotFound is returned when a session doesn't exist, or has expired.
+var ErrSessionNotFound = errors.New("session not found")
+
+// Session holds the tokens for a signed in user on the server, so that the
+// browser only needs to hold the session ID.
+type Session struct {
+	IDToken      string
+	AccessToken  string
+	RefreshToken string
+	Expiry       time.Time
+}
+
+func (s *Session) expired() bool {
+	return !s.Expiry.IsZero() && time.Now().After(s.Expiry)
+}
+
+// SessionStore keeps sessions by their ID.
+type SessionStore interface {
+	// Get returns the session, or ErrSessionNotFound if it doesn't exist or
+	// has expired.
+	Get(ctx context.Context, id string) (*Session, error)
+	// Set creates or replaces the session.
+	Set(ctx context.Context, id string, session *Session) error
+	// Delete removes the session, it's not an error if it doesn't exist.
+	Delete(ctx context.Context, id string) error
+	// Prune removes all the expired sessions.
+	Prune(ctx context.Context) error
+}
+
+// NewSessionID returns a new random, opaque, session ID.
+func NewSessionID() (string, error) {
+	b := make([]byte, 32)
+
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// MemorySessionStore keeps sessions in memory. Sessions are lost when the
+// server restarts, and aren't shared between replicas.
+type MemorySessionStore struct {
+	mu       sync.Mutex
+	sessions map[string]Session
+}
+
+// NewMemorySessionStore creates an empty MemorySessionStore.
+func NewMemorySessionStore() *MemorySessionStore {
+	return &MemorySessionStore{sessions: map[string]Session{}}
+}
+
+func (m *MemorySessionStore) Get(ctx context.Context, id string) (*Session, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	session, ok := m.sessions[id]
+	if !ok {
+		return nil, ErrSessionNotFound
+	}
+
+	if session.expired() {
+		delete(m.sessions, id)
+		return nil, ErrSessionNotFound
+	}
+
+	return &session, nil
+}
+
+func (m *MemorySessionStore) Set(ctx context.Context, id string, session *Session) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.sessions[id] = *session
+
+	return nil
+}
+
+func (m *MemorySessionStore) Delete(ctx context.Context, id string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	delete(m.sessions, id)
+
+	return nil
+}
+
+func (m *MemorySessionStore) Prune(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for id, session := range m.sessions {
+		if session.expired() {
+			delete(m.sessions, id)
+		}
+	}
+
+	return nil
+}
+
+// SecretSessionStore keeps each session in a Secret in the server's
+// namespace, so that sessions survive restarts and are shared between
+// replicas. The Secrets are named after a hash of the session ID, so the ID
+// itself is never stored.
+type SecretSessionStore struct {
+	client    ctrlclient.Client
+	namespace string
+}
+
+// NewSecretSessionStore creates a SecretSessionStore that stores sessions in
+// the namespace.
+func NewSecretSessionStore(client ctrlclient.Client, namespace string) *SecretSessionStore {
+	return &SecretSessionStore{client: client, namespace: namespace}
+}
+
+func (s *SecretSessionStore) Get(ctx context.Context, id string) (*Session, error) {
+	secret := &corev1.Secret{}
+
+	if err := s.client.Get(ctx, s.key(id), secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, ErrSessionNotFound
+		}
+
+		return nil, fmt.Errorf("getting session: %w", err)
+	}
+
+	session, err := sessionFromSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	if session.expired() {
+		if err := s.Delete(ctx, id); err != nil {
+			return nil, err
+		}
+
+		return nil, ErrSessionNotFound
+	}
+
+	return session, nil
+}
+
+func (s *SecretSessionStore) Set(ctx context.Context, id string, session *Session) error {
+	key := s.key(id)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      key.Name,
+			Namespace: key.Namespace,
+			Labels:    map[string]string{SessionSecretLabel: "true"},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			"idToken":      []byte(session.IDToken),
+			"accessToken":  []byte(session.AccessToken),
+			"refreshToken": []byte(session.RefreshToken),
+			"expiry":       []byte(session.Expiry.UTC().Format(time.RFC3339)),
+		},
+	}
+
+	err := s.client.Create(ctx, secret)
+	if apierrors.IsAlreadyExists(err) {
+		err = s.client.Update(ctx, secret)
+	}
+
+	if err != nil {
+		return fmt.Errorf("saving session: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SecretSessionStore) Delete(ctx context.Context, id string) error {
+	key := s.key(id)
+
+	secret := &corev1.Secret{}
+	secret.Name = key.Name
+	secret.Namespace = key.Namespace
+
+	if err := s.client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+		return fmt.Errorf("deleting session: %w", err)
+	}
+
+	return nil
+}
+
+func (s *SecretSessionStore) Prune(ctx context.Context) error {
+	secrets := &corev1.SecretList{}
+
+	opts := []ctrlclient.ListOption{
+		ctrlclient.InNamespace(s.namespace),
+		ctrlclient.MatchingLabels{SessionSecretLabel: "true"},
+	}
+
+	if err := s.client.List(ctx, secrets, opts...); err != nil {
+		return fmt.Errorf("listing sessions: %w", err)
+	}
+
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+
+		// Sessions that can't be read can't be used either.
+		if session, err := sessionFromSecret(secret); err == nil && !session.expired() {
+			continue
+		}
+
+		if err := s.client.Delete(ctx, secret); err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("deleting session: %w", err)
+		}
+	}
+
+	return nil
+}
+
+func (s *SecretSessionStore) key(id string) ctrlclient.ObjectKey {
+	hash := sha256.Sum256([]byte(id))
+
+	return ctrlclient.ObjectKey{
+		Name:      sessionSecretPrefix + hex.EncodeToString(hash[:]),
+		Namespace: s.namespace,
+	}
+}
+
+func sessionFromSecret(secret *corev1.Secret) (*Session, error) {
+	expiry, err := time.Parse(time.RFC3339, string(secret.Data["expiry"]))
+	if err != nil {
+		return nil, fmt.Errorf("invalid expiry in session %s: %w", secret.Name, err)
+	}
+
+	return &Session{
+		IDToken:      string(secret.Data["idToken"]),
+		AccessToken:  string(secret.Data["accessToken"]),
+		RefreshToken: string(secret.Data["refreshToken"]),
+		Expiry:       expiry,
+	}, nil
+}
@@ -0,0 +1,90 @@
+package auth_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	corev1 "k8s.io/api/core/v1"
+	ctrlclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlclientfake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestSessionStores(t *testing.T) {
+	stores := map[string]func() auth.SessionStore{
+		"memory": func() auth.SessionStore { return auth.NewMemorySessionStore() },
+		"secret": func() auth.SessionStore {
+			return auth.NewSecretSessionStore(ctrlclientfake.NewClientBuilder().Build(), testNamespace)
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			g := NewGomegaWithT(t)
+			ctx := context.Background()
+			store := newStore()
+
+			id, err := auth.NewSessionID()
+			g.Expect(err).NotTo(HaveOccurred())
+
+			_, err = store.Get(ctx, id)
+			g.Expect(err).To(MatchError(auth.ErrSessionNotFound))
+
+			session := &auth.Session{IDToken: "id", AccessToken: "access", RefreshToken: "refresh", Expiry: time.Now().Add(time.Hour).Truncate(time.Second)}
+			g.Expect(store.Set(ctx, id, session)).To(Succeed())
+
+			got, err := store.Get(ctx, id)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got.IDToken).To(Equal("id"))
+			g.Expect(got.RefreshToken).To(Equal("refresh"))
+			g.Expect(got.Expiry.Equal(session.Expiry)).To(BeTrue())
+
+			session.IDToken = "refreshed"
+			g.Expect(store.Set(ctx, id, session)).To(Succeed())
+
+			got, err = store.Get(ctx, id)
+			g.Expect(err).NotTo(HaveOccurred())
+			g.Expect(got.IDToken).To(Equal("refreshed"))
+
+			g.Expect(store.Delete(ctx, id)).To(Succeed())
+			g.Expect(store.Delete(ctx, id)).To(Succeed())
+
+			_, err = store.Get(ctx, id)
+			g.Expect(err).To(MatchError(auth.ErrSessionNotFound))
+
+			g.Expect(store.Set(ctx, "expired", &auth.Session{IDToken: "id", Expiry: time.Now().Add(-time.Minute)})).To(Succeed())
+
+			_, err = store.Get(ctx, "expired")
+			g.Expect(err).To(MatchError(auth.ErrSessionNotFound))
+		})
+	}
+}
+
+func TestSecretSessionStorePrune(t *testing.T) {
+	g := NewGomegaWithT(t)
+	ctx := context.Background()
+
+	client := ctrlclientfake.NewClientBuilder().Build()
+	store := auth.NewSecretSessionStore(client, testNamespace)
+
+	g.Expect(store.Set(ctx, "live", &auth.Session{IDToken: "id", Expiry: time.Now().Add(time.Hour)})).To(Succeed())
+	g.Expect(store.Set(ctx, "expired", &auth.Session{IDToken: "id", Expiry: time.Now().Add(-time.Hour)})).To(Succeed())
+
+	secrets := &corev1.SecretList{}
+	g.Expect(client.List(ctx, secrets, ctrlclient.MatchingLabels{auth.SessionSecretLabel: "true"})).To(Succeed())
+	g.Expect(secrets.Items).To(HaveLen(2))
+
+	for _, s := range secrets.Items {
+		g.Expect(s.Name).NotTo(ContainSubstring("live"))
+	}
+
+	g.Expect(store.Prune(ctx)).To(Succeed())
+
+	g.Expect(client.List(ctx, secrets, ctrlclient.MatchingLabels{auth.SessionSecretLabel: "true"})).To(Succeed())
+	g.Expect(secrets.Items).To(HaveLen(1))
+
+	_, err := store.Get(ctx, "live")
+	g.Expect(err).NotTo(HaveOccurred())
+}
@@ -11,10 +11,12 @@ import (
 
 type AdminClaims struct {
 	jwt.RegisteredClaims
+	// Groups are the groups the local account is impersonated with.
+	Groups []string `json:"groups,omitempty"`
 }
 
 type TokenSigner interface {
-	Sign(subject string) (string, error)
+	Sign(subject string, groups []string) (string, error)
 }
 
 type TokenVerifier interface {
@@ -47,7 +49,7 @@ func NewHMACTokenSignerVerifier(expireAfter time.Duration) (*HMACTokenSignerVeri
 	}, nil
 }
 
-func (sv *HMACTokenSignerVerifier) Sign(subject string) (string, error) {
+func (sv *HMACTokenSignerVerifier) Sign(subject string, groups []string) (string, error) {
 	claims := AdminClaims{
 		RegisteredClaims: jwt.RegisteredClaims{
 			IssuedAt:  jwt.NewNumericDate(time.Now().UTC()),
@@ -55,6 +57,7 @@ func (sv *HMACTokenSignerVerifier) Sign(subject string) (string, error) {
 			NotBefore: jwt.NewNumericDate(time.Now().UTC()),
 			Subject:   subject,
 		},
+		Groups: groups,
 	}
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
@@ -96,3 +99,12 @@ func (sv *HMACTokenSignerVerifier) Verify(tokenString string) (*AdminClaims, err
 func (sv *HMACTokenSignerVerifier) SetDevMode(enabled bool) {
 	sv.devMode = enabled
 }
+
+// principalGroups returns the groups for the UserPrincipal, which are never nil.
+func (c *AdminClaims) principalGroups() []string {
+	if c.Groups == nil {
+		return []string{}
+	}
+
+	return c.Groups
+}
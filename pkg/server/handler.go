@@ -21,18 +21,33 @@ var (
 type Config struct {
 	CoreServerConfig core.CoreServerConfig
 	AuthServer       *auth.AuthServer
+	// RPCMetrics, when set, records metrics for every core API call.
+	RPCMetrics *middleware.RPCMetrics
 }
 
 // NewHandlers creates and returns a new server configured to serve the core
 // application.
 func NewHandlers(ctx context.Context, log logr.Logger, cfg *Config) (http.Handler, error) {
-	mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(log))
+	opts := []runtime.ServeMuxOption{
+		middleware.WithGrpcErrorLogging(log),
+		middleware.GatewayTracingServeMuxOption(),
+	}
+	if cfg.RPCMetrics != nil {
+		opts = append(opts, cfg.RPCMetrics.ServeMuxOption())
+	}
+
+	mux := runtime.NewServeMux(opts...)
 
 	if err := core.Hydrate(ctx, mux, cfg.CoreServerConfig); err != nil {
 		return nil, fmt.Errorf("could not start up core servers: %w", err)
 	}
 
-	httpHandler := auth.WithAPIAuth(mux, cfg.AuthServer, PublicRoutes)
+	apiHandler := middleware.WithGatewayTracing(mux)
+	if cfg.RPCMetrics != nil {
+		apiHandler = cfg.RPCMetrics.Handler(apiHandler)
+	}
+
+	httpHandler := auth.WithAPIAuth(apiHandler, cfg.AuthServer, PublicRoutes)
 
 	return httpHandler, nil
 }
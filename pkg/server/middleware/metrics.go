@@ -0,0 +1,126 @@
+package middleware
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus"
+	"google.golang.org/grpc/metadata"
+)
+
+// UnknownCluster is the cluster label of calls that name a cluster the
+// server doesn't know about, so that made up names can't blow up the number
+// of series.
+const UnknownCluster = "unknown"
+
+type rpcCallKey struct{}
+
+// rpcCall is filled in by the gateway with the method it routed a request to.
+type rpcCall struct {
+	method string
+}
+
+// RPCMetrics records how long every core API call takes, and its outcome,
+// labelled by the method and the cluster it was made against.
+type RPCMetrics struct {
+	duration     *prometheus.HistogramVec
+	knownCluster func(name string) bool
+}
+
+// NewRPCMetrics registers the metrics with the registerer. The cluster
+// label is only set to the names knownCluster accepts.
+func NewRPCMetrics(reg prometheus.Registerer, knownCluster func(name string) bool) (*RPCMetrics, error) {
+	m := &RPCMetrics{
+		duration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Namespace: "gitops",
+			Subsystem: "api",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of core API calls, by method, cluster and HTTP status code. The cluster is empty for calls across all clusters.",
+			Buckets:   prometheus.DefBuckets,
+		}, []string{"method", "cluster", "code"}),
+		knownCluster: knownCluster,
+	}
+
+	if err := reg.Register(m.duration); err != nil {
+		return nil, err
+	}
+
+	return m, nil
+}
+
+// ServeMuxOption makes the gateway note the method it routes each call to.
+// The metadata annotators are the only hook the gateway calls with the
+// method in the context, they add no metadata here.
+func (m *RPCMetrics) ServeMuxOption() runtime.ServeMuxOption {
+	return runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+		noteRPCMethod(ctx)
+		return nil
+	})
+}
+
+// Handler records the metrics for calls to the gateway mux it wraps. Requests
+// that aren't routed to a core API method aren't recorded.
+func (m *RPCMetrics) Handler(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		call := &rpcCall{}
+		cluster := m.clusterLabel(r)
+		recorder := &statusRecorder{ResponseWriter: w, Status: http.StatusOK}
+		start := time.Now()
+
+		next.ServeHTTP(recorder, r.WithContext(context.WithValue(r.Context(), rpcCallKey{}, call)))
+
+		if call.method == "" {
+			return
+		}
+
+		m.duration.WithLabelValues(call.method, cluster, strconv.Itoa(recorder.Status)).Observe(time.Since(start).Seconds())
+	})
+}
+
+// clusterLabel finds the cluster a call is made against, from the
+// clusterName query parameter, or the clusterName field of a JSON body.
+func (m *RPCMetrics) clusterLabel(r *http.Request) string {
+	cluster := r.URL.Query().Get("clusterName")
+
+	if cluster == "" && r.Body != nil && strings.HasPrefix(r.Header.Get("Content-Type"), "application/json") {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			// The gateway will fail reading the body too, and report it.
+			return ""
+		}
+
+		r.Body = io.NopCloser(bytes.NewReader(body))
+
+		var req struct {
+			ClusterName string `json:"clusterName"`
+		}
+
+		if json.Unmarshal(body, &req) == nil {
+			cluster = req.ClusterName
+		}
+	}
+
+	if cluster != "" && !m.knownCluster(cluster) {
+		return UnknownCluster
+	}
+
+	return cluster
+}
+
+func noteRPCMethod(ctx context.Context) {
+	call, ok := ctx.Value(rpcCallKey{}).(*rpcCall)
+	if !ok {
+		return
+	}
+
+	if method, ok := runtime.RPCMethod(ctx); ok {
+		call.method = method[strings.LastIndex(method, "/")+1:]
+	}
+}
@@ -0,0 +1,70 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+)
+
+type listObjectsServer struct {
+	pb.UnimplementedCoreServer
+}
+
+func (listObjectsServer) ListObjects(ctx context.Context, msg *pb.ListObjectsRequest) (*pb.ListObjectsResponse, error) {
+	return &pb.ListObjectsResponse{}, nil
+}
+
+func TestRPCMetrics(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	reg := prometheus.NewRegistry()
+	rpcMetrics, err := middleware.NewRPCMetrics(reg, func(name string) bool { return name == "Default" })
+	g.Expect(err).NotTo(HaveOccurred())
+
+	mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(logr.Discard()), rpcMetrics.ServeMuxOption())
+	g.Expect(pb.RegisterCoreHandlerServer(context.Background(), mux, listObjectsServer{})).To(Succeed())
+
+	handler := rpcMetrics.Handler(mux)
+
+	requests := []*http.Request{
+		httptest.NewRequest(http.MethodPost, "/v1/objects", strings.NewReader(`{"kind": "Kustomization", "clusterName": "Default"}`)),
+		httptest.NewRequest(http.MethodPost, "/v1/objects", strings.NewReader(`{"kind": "Kustomization"}`)),
+		httptest.NewRequest(http.MethodGet, "/v1/object/podinfo?clusterName=made-up", nil),
+		httptest.NewRequest(http.MethodGet, "/v1/not-an-rpc", nil),
+	}
+
+	for _, req := range requests {
+		req.Header.Set("Content-Type", "application/json")
+		handler.ServeHTTP(httptest.NewRecorder(), req)
+	}
+
+	families, err := reg.Gather()
+	g.Expect(err).NotTo(HaveOccurred())
+	g.Expect(families).To(HaveLen(1))
+
+	observed := map[string]uint64{}
+
+	for _, m := range families[0].GetMetric() {
+		labels := map[string]string{}
+		for _, l := range m.GetLabel() {
+			labels[l.GetName()] = l.GetValue()
+		}
+
+		observed[labels["method"]+"/"+labels["cluster"]+"/"+labels["code"]] = m.GetHistogram().GetSampleCount()
+	}
+
+	g.Expect(observed).To(Equal(map[string]uint64{
+		"ListObjects/Default/200": 1,
+		"ListObjects//200":        1,
+		"GetObject/unknown/501":   1,
+	}))
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/weaveworks/weave-gitops/core/logger"
 	"github.com/weaveworks/weave-gitops/pkg/services/auth"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/oauth2"
 )
 
@@ -25,6 +26,13 @@ func (r *statusRecorder) WriteHeader(status int) {
 	r.ResponseWriter.WriteHeader(status)
 }
 
+// Flush passes through to the underlying writer so streaming responses keep working.
+func (r *statusRecorder) Flush() {
+	if f, ok := r.ResponseWriter.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 var RequestOkText = "request success"
 var RequestErrorText = "request error"
 var ServerErrorText = "server error"
@@ -34,9 +42,11 @@ var ServerErrorText = "server error"
 // the error message in the WithLogging http.Handler.
 // Normal gRPC middleware was not working for this:
 // https://github.com/grpc-ecosystem/grpc-gateway/issues/1043
+// The error is also recorded on the call's span.
 func WithGrpcErrorLogging(log logr.Logger) runtime.ServeMuxOption {
 	return runtime.WithErrorHandler(func(ctx context.Context, mux *runtime.ServeMux, marshaler runtime.Marshaler, w http.ResponseWriter, r *http.Request, err error) {
 		log.Error(err, ServerErrorText)
+		trace.SpanFromContext(ctx).RecordError(err)
 		// We don't want to change the behavior of error handling, just intercept for logging.
 		runtime.DefaultHTTPErrorHandler(ctx, mux, marshaler, w, r, err)
 	})
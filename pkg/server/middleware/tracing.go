@@ -0,0 +1,63 @@
+package middleware
+
+import (
+	"context"
+	"net/http"
+	"strings"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/weaveworks/weave-gitops/core/tracing"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.12.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+)
+
+// gatewaySpanName is the name of the span for a call through the gateway
+// until it's routed to a method.
+const gatewaySpanName = "grpc-gateway"
+
+// WithGatewayTracing starts a span for every call to the gateway mux it
+// wraps. GatewayTracingServeMuxOption names it after the method the call is
+// routed to.
+func WithGatewayTracing(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx, span := tracing.Tracer().Start(r.Context(), gatewaySpanName,
+			trace.WithAttributes(semconv.RPCSystemKey.String(gatewaySpanName)))
+		defer span.End()
+
+		recorder := &statusRecorder{ResponseWriter: w, Status: http.StatusOK}
+
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		span.SetAttributes(semconv.HTTPStatusCodeKey.Int(recorder.Status))
+
+		if recorder.Status >= http.StatusInternalServerError {
+			span.SetStatus(codes.Error, http.StatusText(recorder.Status))
+		}
+	})
+}
+
+// GatewayTracingServeMuxOption names the span WithGatewayTracing started
+// after the method the call is routed to. Like the RPCMetrics, it uses a
+// metadata annotator, as that's the only hook called with the method.
+func GatewayTracingServeMuxOption() runtime.ServeMuxOption {
+	return runtime.WithMetadata(func(ctx context.Context, r *http.Request) metadata.MD {
+		method, ok := runtime.RPCMethod(ctx)
+		if !ok {
+			return nil
+		}
+
+		span := trace.SpanFromContext(ctx)
+		span.SetName(strings.TrimPrefix(method, "/"))
+
+		if i := strings.LastIndex(method, "/"); i > 0 {
+			span.SetAttributes(
+				semconv.RPCServiceKey.String(strings.TrimPrefix(method[:i], "/")),
+				semconv.RPCMethodKey.String(method[i+1:]),
+			)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,62 @@
+package middleware_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	. "github.com/onsi/gomega"
+	pb "github.com/weaveworks/weave-gitops/pkg/api/core"
+	"github.com/weaveworks/weave-gitops/pkg/server/middleware"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+)
+
+func TestGatewayTracing(t *testing.T) {
+	g := NewGomegaWithT(t)
+
+	recorder := tracetest.NewSpanRecorder()
+	previous := otel.GetTracerProvider()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	t.Cleanup(func() { otel.SetTracerProvider(previous) })
+
+	mux := runtime.NewServeMux(middleware.WithGrpcErrorLogging(logr.Discard()), middleware.GatewayTracingServeMuxOption())
+	g.Expect(pb.RegisterCoreHandlerServer(context.Background(), mux, listObjectsServer{})).To(Succeed())
+
+	handler := middleware.WithGatewayTracing(mux)
+
+	req := httptest.NewRequest(http.MethodPost, "/v1/objects", strings.NewReader(`{"kind": "Kustomization"}`))
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	req = httptest.NewRequest(http.MethodGet, "/v1/object/podinfo", nil)
+	handler.ServeHTTP(httptest.NewRecorder(), req)
+
+	spans := recorder.Ended()
+	g.Expect(spans).To(HaveLen(2))
+
+	g.Expect(spans[0].Name()).To(Equal("gitops_core.v1.Core/ListObjects"))
+	g.Expect(spans[0].Status().Code).To(Equal(codes.Unset))
+	g.Expect(spans[0].Events()).To(BeEmpty())
+
+	attributes := map[string]string{}
+	for _, a := range spans[0].Attributes() {
+		attributes[string(a.Key)] = a.Value.Emit()
+	}
+
+	g.Expect(attributes).To(HaveKeyWithValue("rpc.service", "gitops_core.v1.Core"))
+	g.Expect(attributes).To(HaveKeyWithValue("rpc.method", "ListObjects"))
+	g.Expect(attributes).To(HaveKeyWithValue("http.status_code", "200"))
+
+	// Unimplemented methods fail, and the error is recorded.
+	g.Expect(spans[1].Name()).To(Equal("gitops_core.v1.Core/GetObject"))
+	g.Expect(spans[1].Status().Code).To(Equal(codes.Error))
+	g.Expect(spans[1].Events()).To(HaveLen(1))
+	g.Expect(spans[1].Events()[0].Name).To(Equal("exception"))
+}
@@ -1,72 +1,417 @@
 package check
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
-	"os/exec"
+	"io"
+	"sort"
 	"strings"
 
 	"github.com/Masterminds/semver/v3"
+	helmv2 "github.com/fluxcd/helm-controller/api/v2beta1"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
+	sourcev1 "github.com/fluxcd/source-controller/api/v1beta2"
+	"github.com/weaveworks/weave-gitops/core/nsaccess"
+	coretypes "github.com/weaveworks/weave-gitops/core/server/types"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/discovery"
+	typedauth "k8s.io/client-go/kubernetes/typed/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 )
 
 const (
 	kubernetesConstraints = ">=1.20.6-0"
+
+	// gitopsServerNameLabel is set by the Helm chart on the gitops-server deployment.
+	gitopsServerNameLabel = "app.kubernetes.io/name"
+	gitopsServerName      = "weave-gitops"
+)
+
+// Status is the outcome of a single check.
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
 )
 
-// Pre runs pre-install checks
-func Pre() (string, error) {
-	k8sOutput, err := runKubernetesCheck()
+// Result is the outcome of a single check, and why.
+type Result struct {
+	Name    string `json:"name"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+}
+
+// Summary is the outcome of all the checks. It has passed if none of them failed.
+type Summary struct {
+	Passed  bool     `json:"passed"`
+	Results []Result `json:"results"`
+}
+
+// fluxCRDs are the Flux CRDs Weave GitOps works with, and the API version it uses for each.
+var fluxCRDs = map[string]string{
+	"kustomizations." + kustomizev1.GroupVersion.Group: kustomizev1.GroupVersion.Version,
+	"helmreleases." + helmv2.GroupVersion.Group:        helmv2.GroupVersion.Version,
+	"gitrepositories." + sourcev1.GroupVersion.Group:   sourcev1.GroupVersion.Version,
+	"ocirepositories." + sourcev1.GroupVersion.Group:   sourcev1.GroupVersion.Version,
+	"helmrepositories." + sourcev1.GroupVersion.Group:  sourcev1.GroupVersion.Version,
+	"helmcharts." + sourcev1.GroupVersion.Group:        sourcev1.GroupVersion.Version,
+	"buckets." + sourcev1.GroupVersion.Group:           sourcev1.GroupVersion.Version,
+}
+
+// Checker checks that a cluster is ready to run Weave GitOps.
+type Checker struct {
+	Discovery discovery.DiscoveryInterface
+	Client    client.Client
+	Auth      typedauth.AuthorizationV1Interface
+	// Namespace is the namespace the gitops-server is installed in.
+	Namespace string
+	// NamespaceAccessRulesConfigMap is the ConfigMap in Namespace the
+	// gitops-server reads its namespace access rules from, if it's given one.
+	NamespaceAccessRulesConfigMap string
+}
+
+// Run runs every check, carrying on past failures so the report is complete.
+func (c Checker) Run(ctx context.Context) Summary {
+	report := Summary{Passed: true}
+
+	add := func(results ...Result) {
+		for _, r := range results {
+			if r.Status == StatusFail {
+				report.Passed = false
+			}
+
+			report.Results = append(report.Results, r)
+		}
+	}
+
+	add(c.checkKubernetes())
+	add(c.checkFluxControllers(ctx)...)
+	add(c.checkFluxCRDs(ctx)...)
+
+	server, installed := c.checkGitopsServer(ctx)
+	add(server)
+
+	// Before Weave GitOps is installed there's no server, secret or RBAC to
+	// find yet, so what's missing is only worth a warning.
+	add(warnUnlessInstalled(installed, c.checkAuthSecrets(ctx), c.checkPermissions(ctx))...)
+
+	return report
+}
+
+func (c Checker) checkKubernetes() Result {
+	result := Result{Name: "Kubernetes version"}
+
+	serverVersion, err := c.Discovery.ServerVersion()
+	if err != nil {
+		return fail(result, "unable to get kubernetes version: %v", err)
+	}
+
+	v, err := parseVersion(serverVersion.GitVersion)
 	if err != nil {
-		return "", err
+		return fail(result, "kubernetes version can't be determined: %v", err)
+	}
+
+	return checkKubernetesVersion(result, v)
+}
+
+func (c Checker) checkFluxControllers(ctx context.Context) []Result {
+	result := Result{Name: "Flux controllers"}
+
+	namespaces := &corev1.NamespaceList{}
+	if err := c.Client.List(ctx, namespaces); err != nil {
+		return []Result{fail(result, "listing namespaces: %v", err)}
+	}
+
+	fluxNamespaces := coretypes.FilterFluxNamespaces(namespaces.Items)
+	if len(fluxNamespaces) == 0 {
+		return []Result{fail(result, "could not find flux namespace in cluster")}
+	}
+
+	results := []Result{}
+
+	for _, ns := range fluxNamespaces {
+		deployments := &appsv1.DeploymentList{}
+
+		opts := []client.ListOption{
+			client.InNamespace(ns.Name),
+			client.MatchingLabels{coretypes.PartOfLabel: coretypes.FluxNamespacePartOf},
+		}
+
+		if err := c.Client.List(ctx, deployments, opts...); err != nil {
+			results = append(results, fail(result, "listing deployments in %s: %v", ns.Name, err))
+			continue
+		}
+
+		for _, d := range deployments.Items {
+			r := Result{Name: fmt.Sprintf("Flux controller %s/%s", d.Namespace, d.Name)}
+
+			if !deploymentAvailable(d) {
+				results = append(results, fail(r, "%d of %d replicas available", d.Status.AvailableReplicas, desiredReplicas(d)))
+				continue
+			}
+
+			results = append(results, pass(r, "%s", strings.Join(deploymentImages(d), ", ")))
+		}
+	}
+
+	if len(results) == 0 {
+		return []Result{fail(result, "no Flux controllers found, is Flux installed?")}
+	}
+
+	return results
+}
+
+func (c Checker) checkFluxCRDs(ctx context.Context) []Result {
+	results := []Result{}
+
+	for _, name := range sortedKeys(fluxCRDs) {
+		version := fluxCRDs[name]
+		result := Result{Name: "Flux CRD " + name}
+
+		crd := &apiextensionsv1.CustomResourceDefinition{}
+
+		if err := c.Client.Get(ctx, client.ObjectKey{Name: name}, crd); err != nil {
+			if k8serrors.IsNotFound(err) {
+				results = append(results, fail(result, "not installed, Weave GitOps needs %s", version))
+			} else {
+				results = append(results, fail(result, "getting CRD: %v", err))
+			}
+
+			continue
+		}
+
+		if !servesVersion(crd, version) {
+			results = append(results, fail(result, "doesn't serve %s, which Weave GitOps needs, upgrade Flux", version))
+			continue
+		}
+
+		results = append(results, pass(result, "serves %s", version))
+	}
+
+	return results
+}
+
+// checkGitopsServer also reports whether a gitops-server deployment exists at all.
+func (c Checker) checkGitopsServer(ctx context.Context) (Result, bool) {
+	result := Result{Name: "Weave GitOps server"}
+
+	deployments := &appsv1.DeploymentList{}
+
+	opts := []client.ListOption{
+		client.InNamespace(c.Namespace),
+		client.MatchingLabels{gitopsServerNameLabel: gitopsServerName},
+	}
+
+	if err := c.Client.List(ctx, deployments, opts...); err != nil {
+		return fail(result, "listing deployments in %s: %v", c.Namespace, err), false
+	}
+
+	if len(deployments.Items) == 0 {
+		return warn(result, "no deployment labelled %s=%s in namespace %s, Weave GitOps isn't installed yet", gitopsServerNameLabel, gitopsServerName, c.Namespace), false
+	}
+
+	d := deployments.Items[0]
+
+	if !deploymentAvailable(d) {
+		return fail(result, "%s/%s has %d of %d replicas available", d.Namespace, d.Name, d.Status.AvailableReplicas, desiredReplicas(d)), true
+	}
+
+	return pass(result, "%s/%s is available", d.Namespace, d.Name), true
+}
+
+// warnUnlessInstalled downgrades failures to warnings when Weave GitOps isn't installed.
+func warnUnlessInstalled(installed bool, results ...Result) []Result {
+	if installed {
+		return results
+	}
+
+	for i := range results {
+		if results[i].Status == StatusFail {
+			results[i].Status = StatusWarn
+		}
 	}
 
-	return k8sOutput, nil
+	return results
 }
 
-func runKubernetesCheck() (string, error) {
-	versionOutput, err := exec.Command("kubectl", "version", "--short").CombinedOutput()
+func (c Checker) checkAuthSecrets(ctx context.Context) Result {
+	result := Result{Name: "Authentication secrets"}
+
+	found := []string{}
+
+	for _, name := range []string{auth.ClusterUserAuthSecretName, auth.DefaultOIDCAuthSecretName} {
+		secret := &corev1.Secret{}
+
+		err := c.Client.Get(ctx, client.ObjectKey{Name: name, Namespace: c.Namespace}, secret)
+		if k8serrors.IsNotFound(err) {
+			continue
+		}
+
+		if err != nil {
+			return warn(result, "unable to check for secret %s/%s: %v", c.Namespace, name, err)
+		}
+
+		found = append(found, name)
+	}
+
+	if len(found) == 0 {
+		return fail(result, "neither %s nor %s exist in namespace %s, nobody will be able to log in", auth.ClusterUserAuthSecretName, auth.DefaultOIDCAuthSecretName, c.Namespace)
+	}
+
+	return pass(result, "found %s", strings.Join(found, ", "))
+}
+
+func (c Checker) checkPermissions(ctx context.Context) Result {
+	result := Result{Name: "User permissions"}
+
+	ns := corev1.Namespace{}
+	ns.Name = c.Namespace
+
+	checker, err := c.namespaceChecker(ctx)
+	if err != nil {
+		return fail(result, "reading the namespace access rules: %v", err)
+	}
+
+	accessible, err := checker.FilterAccessibleNamespaces(ctx, c.Auth, []corev1.Namespace{ns})
 	if err != nil {
-		return "", fmt.Errorf("unable to get kubernetes version: %w", err)
+		return fail(result, "checking access to namespace %s: %v", c.Namespace, err)
+	}
+
+	if len(accessible) == 0 {
+		return fail(result, "the current user doesn't have the permissions needed to use Weave GitOps in namespace %s", c.Namespace)
+	}
+
+	return pass(result, "the current user can use Weave GitOps in namespace %s", c.Namespace)
+}
+
+// namespaceChecker checks the rules the gitops-server checks, the built in
+// ones unless it's given a ConfigMap.
+func (c Checker) namespaceChecker(ctx context.Context) (nsaccess.Checker, error) {
+	if c.NamespaceAccessRulesConfigMap == "" {
+		return nsaccess.NewChecker(nsaccess.DefautltWegoAppRules), nil
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := c.Client.Get(ctx, client.ObjectKey{Namespace: c.Namespace, Name: c.NamespaceAccessRulesConfigMap}, cm); err != nil {
+		return nil, err
 	}
 
-	v, err := parseVersion(string(versionOutput))
+	rules, err := nsaccess.RulesFromConfigMap(cm)
 	if err != nil {
-		return "", fmt.Errorf("kubernetes version can't be determined: %w", err)
+		return nil, err
 	}
 
-	return checkKubernetesVersion(v)
+	return nsaccess.NewRulesChecker(rules), nil
 }
 
-func checkKubernetesVersion(version *semver.Version) (string, error) {
-	var valid bool
+// PrintText writes the report as one line per check.
+func PrintText(w io.Writer, report Summary) error {
+	symbols := map[Status]string{StatusPass: "✔", StatusWarn: "⚠", StatusFail: "✗"}
+
+	for _, r := range report.Results {
+		if _, err := fmt.Fprintf(w, "%s %s: %s\n", symbols[r.Status], r.Name, r.Message); err != nil {
+			return err
+		}
+	}
 
-	var vrange string
+	return nil
+}
+
+// PrintJSON writes the report as JSON, for CI.
+func PrintJSON(w io.Writer, report Summary) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	return enc.Encode(report)
+}
 
+func checkKubernetesVersion(result Result, version *semver.Version) Result {
 	c, _ := semver.NewConstraint(kubernetesConstraints)
-	if c.Check(version) {
-		valid = true
-		vrange = kubernetesConstraints
+	if !c.Check(version) {
+		return fail(result, "kubernetes version %s does not match %s", version.Original(), kubernetesConstraints)
 	}
 
-	if !valid {
-		return "", fmt.Errorf("✗ kubernetes version %s does not match %s", version.Original(), kubernetesConstraints)
+	return pass(result, "Kubernetes %s %s", version.String(), kubernetesConstraints)
+}
+
+// parseVersion parses the git version the API server reports, e.g. v1.24.3+k3s1.
+func parseVersion(gitVersion string) (*semver.Version, error) {
+	return semver.NewVersion(strings.TrimPrefix(gitVersion, "v"))
+}
+
+func deploymentAvailable(d appsv1.Deployment) bool {
+	for _, cond := range d.Status.Conditions {
+		if cond.Type == appsv1.DeploymentAvailable {
+			return cond.Status == corev1.ConditionTrue && d.Status.AvailableReplicas >= desiredReplicas(d)
+		}
+	}
+
+	return false
+}
+
+func desiredReplicas(d appsv1.Deployment) int32 {
+	if d.Spec.Replicas == nil {
+		return 1
 	}
 
-	return fmt.Sprintf("✔ Kubernetes %s %s", version.String(), vrange), nil
+	return *d.Spec.Replicas
 }
 
-func parseVersion(text string) (*semver.Version, error) {
-	version := ""
-	lines := strings.Split(text, "\n")
+func deploymentImages(d appsv1.Deployment) []string {
+	images := []string{}
 
-	for _, line := range lines {
-		if strings.Contains(line, "Server") {
-			version = strings.Replace(line, "Server Version: v", "", 1)
+	for _, c := range d.Spec.Template.Spec.Containers {
+		images = append(images, c.Image)
+	}
+
+	return images
+}
+
+func servesVersion(crd *apiextensionsv1.CustomResourceDefinition, version string) bool {
+	for _, v := range crd.Spec.Versions {
+		if v.Name == version && v.Served {
+			return true
 		}
 	}
 
-	if _, err := semver.StrictNewVersion(version); err != nil {
-		return nil, err
+	return false
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
 
-	return semver.NewVersion(version)
+	sort.Strings(keys)
+
+	return keys
+}
+
+func pass(r Result, format string, a ...interface{}) Result {
+	r.Status = StatusPass
+	r.Message = fmt.Sprintf(format, a...)
+
+	return r
+}
+
+func warn(r Result, format string, a ...interface{}) Result {
+	r.Status = StatusWarn
+	r.Message = fmt.Sprintf(format, a...)
+
+	return r
+}
+
+func fail(r Result, format string, a ...interface{}) Result {
+	r.Status = StatusFail
+	r.Message = fmt.Sprintf(format, a...)
+
+	return r
 }
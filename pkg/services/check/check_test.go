@@ -1,10 +1,27 @@
 package check
 
 import (
-	"github.com/Masterminds/semver/v3"
+	"context"
 
+	"github.com/Masterminds/semver/v3"
+	kustomizev1 "github.com/fluxcd/kustomize-controller/api/v1beta2"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/weaveworks/weave-gitops/core/nsaccess"
+	"github.com/weaveworks/weave-gitops/pkg/kube"
+	"github.com/weaveworks/weave-gitops/pkg/server/auth"
+	appsv1 "k8s.io/api/apps/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("Check kubernetes version", func() {
@@ -12,18 +29,20 @@ var _ = Describe("Check kubernetes version", func() {
 		version, err := semver.NewVersion("1.21.1")
 		Expect(err).ShouldNot(HaveOccurred())
 
-		output, err := checkKubernetesVersion(version)
-		Expect(err).ShouldNot(HaveOccurred())
+		result := checkKubernetesVersion(Result{}, version)
 
-		Expect(output).To(Equal("✔ Kubernetes 1.21.1 >=1.20.6-0"))
+		Expect(result.Status).To(Equal(StatusPass))
+		Expect(result.Message).To(Equal("Kubernetes 1.21.1 >=1.20.6-0"))
 	})
 
 	It("should fail with version does not match", func() {
 		version, err := semver.NewVersion("1.19.1")
 		Expect(err).ShouldNot(HaveOccurred())
 
-		_, err = checkKubernetesVersion(version)
-		Expect(err.Error()).Should(Equal("✗ kubernetes version 1.19.1 does not match >=1.20.6-0"))
+		result := checkKubernetesVersion(Result{}, version)
+
+		Expect(result.Status).To(Equal(StatusFail))
+		Expect(result.Message).To(Equal("kubernetes version 1.19.1 does not match >=1.20.6-0"))
 	})
 })
 
@@ -32,9 +51,182 @@ var _ = Describe("parse version", func() {
 		expectedVersion, err := semver.NewVersion("1.21.1")
 		Expect(err).ShouldNot(HaveOccurred())
 
-		output, err := parseVersion("Server Version: v1.21.1")
+		output, err := parseVersion("v1.21.1")
 		Expect(err).ShouldNot(HaveOccurred())
 
 		Expect(output).To(Equal(expectedVersion))
 	})
+
+	It("should parse versions with build metadata", func() {
+		output, err := parseVersion("v1.24.3+k3s1")
+		Expect(err).ShouldNot(HaveOccurred())
+
+		Expect(output.String()).To(Equal("1.24.3+k3s1"))
+	})
+})
+
+var _ = Describe("Checker", func() {
+	var (
+		clientset      *fake.Clientset
+		objects        []client.Object
+		rulesConfigMap string
+	)
+
+	BeforeEach(func() {
+		rulesConfigMap = ""
+		clientset = fake.NewSimpleClientset()
+		clientset.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.24.3"}
+		clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &authorizationv1.SelfSubjectRulesReview{
+				Status: authorizationv1.SubjectRulesReviewStatus{
+					ResourceRules: []authorizationv1.ResourceRule{{APIGroups: []string{"*"}, Resources: []string{"*"}, Verbs: []string{"*"}}},
+				},
+			}, nil
+		})
+
+		objects = []client.Object{
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "flux-system"}},
+			availableDeployment("kustomize-controller", map[string]string{"app.kubernetes.io/part-of": "flux"}),
+			availableDeployment("ww-gitops-weave-gitops", map[string]string{"app.kubernetes.io/name": "weave-gitops"}),
+			&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: auth.ClusterUserAuthSecretName, Namespace: "flux-system"}},
+		}
+
+		for name, v := range fluxCRDs {
+			objects = append(objects, &apiextensionsv1.CustomResourceDefinition{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+					Versions: []apiextensionsv1.CustomResourceDefinitionVersion{{Name: v, Served: true}},
+				},
+			})
+		}
+	})
+
+	run := func() Summary {
+		scheme, err := kube.CreateScheme()
+		Expect(err).ShouldNot(HaveOccurred())
+
+		checker := Checker{
+			Discovery:                     clientset.Discovery(),
+			Client:                        fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+			Auth:                          clientset.AuthorizationV1(),
+			Namespace:                     "flux-system",
+			NamespaceAccessRulesConfigMap: rulesConfigMap,
+		}
+
+		return checker.Run(context.Background())
+	}
+
+	failed := func(report Summary) []string {
+		names := []string{}
+
+		for _, r := range report.Results {
+			if r.Status == StatusFail {
+				names = append(names, r.Name)
+			}
+		}
+
+		return names
+	}
+
+	It("should pass a healthy cluster", func() {
+		report := run()
+
+		Expect(failed(report)).To(BeEmpty())
+		Expect(report.Passed).To(BeTrue())
+	})
+
+	It("should fail when the Flux CRDs are too old", func() {
+		for _, o := range objects {
+			if crd, ok := o.(*apiextensionsv1.CustomResourceDefinition); ok && crd.Name == "kustomizations."+kustomizev1.GroupVersion.Group {
+				crd.Spec.Versions = []apiextensionsv1.CustomResourceDefinitionVersion{{Name: "v1beta1", Served: true}}
+			}
+		}
+
+		report := run()
+
+		Expect(report.Passed).To(BeFalse())
+		Expect(failed(report)).To(ConsistOf("Flux CRD kustomizations.kustomize.toolkit.fluxcd.io"))
+	})
+
+	It("should fail without a way to log in or the permissions", func() {
+		// Drop the cluster-user-auth secret.
+		objects = append(objects[:3:3], objects[4:]...)
+
+		clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &authorizationv1.SelfSubjectRulesReview{}, nil
+		})
+
+		report := run()
+
+		Expect(report.Passed).To(BeFalse())
+		Expect(failed(report)).To(ConsistOf("Authentication secrets", "User permissions"))
+	})
+
+	It("should check the namespace access rules of the ConfigMap", func() {
+		// The user can only list events, which isn't enough for the built in rules.
+		clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &authorizationv1.SelfSubjectRulesReview{
+				Status: authorizationv1.SubjectRulesReviewStatus{
+					ResourceRules: []authorizationv1.ResourceRule{{APIGroups: []string{""}, Resources: []string{"events"}, Verbs: []string{"get", "list"}}},
+				},
+			}, nil
+		})
+
+		Expect(failed(run())).To(ConsistOf("User permissions"))
+
+		rulesConfigMap = "namespace-access"
+		objects = append(objects, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{Name: rulesConfigMap, Namespace: "flux-system"},
+			Data: map[string]string{
+				nsaccess.RulesConfigMapKey: "required:\n- apiGroups: [\"\"]\n  resources: [events]\n  verbs: [get, list]\n",
+			},
+		})
+
+		report := run()
+
+		Expect(failed(report)).To(BeEmpty())
+		Expect(report.Passed).To(BeTrue())
+	})
+
+	It("should fail when the namespace access rules ConfigMap is missing", func() {
+		rulesConfigMap = "namespace-access"
+
+		report := run()
+
+		Expect(failed(report)).To(ConsistOf("User permissions"))
+	})
+
+	It("should only warn before Weave GitOps is installed", func() {
+		// Drop the gitops-server deployment and the cluster-user-auth secret.
+		objects = append(objects[:2:2], objects[4:]...)
+
+		clientset.PrependReactor("create", "selfsubjectrulesreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+			return true, &authorizationv1.SelfSubjectRulesReview{}, nil
+		})
+
+		report := run()
+
+		Expect(failed(report)).To(BeEmpty())
+		Expect(report.Passed).To(BeTrue())
+
+		warned := []string{}
+
+		for _, r := range report.Results {
+			if r.Status == StatusWarn {
+				warned = append(warned, r.Name)
+			}
+		}
+
+		Expect(warned).To(ConsistOf("Weave GitOps server", "Authentication secrets", "User permissions"))
+	})
 })
+
+func availableDeployment(name string, labels map[string]string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "flux-system", Labels: labels},
+		Status: appsv1.DeploymentStatus{
+			AvailableReplicas: 1,
+			Conditions:        []appsv1.DeploymentCondition{{Type: appsv1.DeploymentAvailable, Status: corev1.ConditionTrue}},
+		},
+	}
+}
@@ -6,6 +6,14 @@
 
 import * as fm from "../../fetch.pb"
 import * as Gitops_coreV1Types from "./types.pb"
+
+export enum DependencyType {
+  DependsOn = "DependsOn",
+  SourceRef = "SourceRef",
+  ChartSourceRef = "ChartSourceRef",
+  Inventory = "Inventory",
+}
+
 export type Pagination = {
   pageSize?: number
   pageToken?: string
@@ -20,11 +28,13 @@ export type ListError = {
 export type ListFluxRuntimeObjectsRequest = {
   namespace?: string
   clusterName?: string
+  pagination?: Pagination
 }
 
 export type ListFluxRuntimeObjectsResponse = {
   deployments?: Gitops_coreV1Types.Deployment[]
   errors?: ListError[]
+  nextToken?: string
 }
 
 export type ListFluxCrdsRequest = {
@@ -52,11 +62,26 @@ export type ListObjectsRequest = {
   kind?: string
   clusterName?: string
   labels?: {[key: string]: string}
+  pagination?: Pagination
 }
 
 export type ListObjectsResponse = {
   objects?: Gitops_coreV1Types.Object[]
   errors?: ListError[]
+  nextToken?: string
+}
+
+export type WatchObjectsRequest = {
+  namespace?: string
+  kind?: string
+  clusterName?: string
+  labels?: {[key: string]: string}
+}
+
+export type WatchObjectsResponse = {
+  type?: string
+  object?: Gitops_coreV1Types.Object
+  error?: ListError
 }
 
 export type GetReconciledObjectsRequest = {
@@ -82,6 +107,67 @@ export type GetChildObjectsResponse = {
   objects?: Gitops_coreV1Types.Object[]
 }
 
+export type GetDependencyGraphRequest = {
+  clusterName?: string
+  namespace?: string
+  root?: Gitops_coreV1Types.ObjectRef
+}
+
+export type DependencyNode = {
+  object?: Gitops_coreV1Types.ObjectRef
+  ready?: Gitops_coreV1Types.Condition
+  suspended?: boolean
+  missing?: boolean
+}
+
+export type DependencyEdge = {
+  from?: Gitops_coreV1Types.ObjectRef
+  to?: Gitops_coreV1Types.ObjectRef
+  type?: DependencyType
+}
+
+export type GetDependencyGraphResponse = {
+  nodes?: DependencyNode[]
+  edges?: DependencyEdge[]
+  errors?: ListError[]
+}
+
+export type GetHelmReleaseHistoryRequest = {
+  name?: string
+  namespace?: string
+  clusterName?: string
+  diffFrom?: number
+  diffTo?: number
+}
+
+export type HelmReleaseRevision = {
+  revision?: number
+  status?: string
+  description?: string
+  chartName?: string
+  chartVersion?: string
+  appVersion?: string
+  values?: string
+  manifest?: string
+  lastDeployed?: string
+}
+
+export type GetHelmReleaseHistoryResponse = {
+  revisions?: HelmReleaseRevision[]
+  diff?: string
+  errors?: ListError[]
+}
+
+export type RollbackHelmReleaseRequest = {
+  name?: string
+  namespace?: string
+  clusterName?: string
+  revision?: number
+}
+
+export type RollbackHelmReleaseResponse = {
+}
+
 export type GetFluxNamespaceRequest = {
 }
 
@@ -173,6 +259,9 @@ export class Core {
   static ListObjects(req: ListObjectsRequest, initReq?: fm.InitReq): Promise<ListObjectsResponse> {
     return fm.fetchReq<ListObjectsRequest, ListObjectsResponse>(`/v1/objects`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static WatchObjects(req: WatchObjectsRequest, entityNotifier?: fm.NotifyStreamEntityArrival<WatchObjectsResponse>, initReq?: fm.InitReq): Promise<void> {
+    return fm.fetchStreamingRequest<WatchObjectsRequest, WatchObjectsResponse>(`/gitops_core.v1.Core/WatchObjects`, entityNotifier, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
   static ListFluxRuntimeObjects(req: ListFluxRuntimeObjectsRequest, initReq?: fm.InitReq): Promise<ListFluxRuntimeObjectsResponse> {
     return fm.fetchReq<ListFluxRuntimeObjectsRequest, ListFluxRuntimeObjectsResponse>(`/v1/flux_runtime_objects?${fm.renderURLSearchParams(req, [])}`, {...initReq, method: "GET"})
   }
@@ -185,6 +274,15 @@ export class Core {
   static GetChildObjects(req: GetChildObjectsRequest, initReq?: fm.InitReq): Promise<GetChildObjectsResponse> {
     return fm.fetchReq<GetChildObjectsRequest, GetChildObjectsResponse>(`/v1/child_objects`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
+  static GetDependencyGraph(req: GetDependencyGraphRequest, initReq?: fm.InitReq): Promise<GetDependencyGraphResponse> {
+    return fm.fetchReq<GetDependencyGraphRequest, GetDependencyGraphResponse>(`/v1/dependency_graph`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
+  static GetHelmReleaseHistory(req: GetHelmReleaseHistoryRequest, initReq?: fm.InitReq): Promise<GetHelmReleaseHistoryResponse> {
+    return fm.fetchReq<GetHelmReleaseHistoryRequest, GetHelmReleaseHistoryResponse>(`/v1/helm_release/${req["name"]}/history?${fm.renderURLSearchParams(req, ["name"])}`, {...initReq, method: "GET"})
+  }
+  static RollbackHelmRelease(req: RollbackHelmReleaseRequest, initReq?: fm.InitReq): Promise<RollbackHelmReleaseResponse> {
+    return fm.fetchReq<RollbackHelmReleaseRequest, RollbackHelmReleaseResponse>(`/v1/helm_release/${req["name"]}/rollback`, {...initReq, method: "POST", body: JSON.stringify(req)})
+  }
   static GetFluxNamespace(req: GetFluxNamespaceRequest, initReq?: fm.InitReq): Promise<GetFluxNamespaceResponse> {
     return fm.fetchReq<GetFluxNamespaceRequest, GetFluxNamespaceResponse>(`/v1/namespace/flux`, {...initReq, method: "POST", body: JSON.stringify(req)})
   }
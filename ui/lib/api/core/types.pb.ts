@@ -102,6 +102,7 @@ export type Namespace = {
   annotations?: {[key: string]: string}
   labels?: {[key: string]: string}
   clusterName?: string
+  kinds?: string[]
 }
 
 export type Event = {